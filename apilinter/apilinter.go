@@ -0,0 +1,290 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package apilinter lints Google APIs defined in Protobuf sources held in
+// memory, for tools that embed the linter rather than running the
+// `api-linter` command.
+//
+// Example:
+//
+//	result, err := apilinter.Lint(ctx, apilinter.Request{
+//		Sources: apilinter.MapSources(map[string]string{
+//			"library.proto": `syntax = "proto3"; ...`,
+//		}),
+//		Files: []string{"library.proto"},
+//	})
+package apilinter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"testing/fstest"
+
+	"github.com/bufbuild/protocompile/reporter"
+	"github.com/googleapis/api-linter/internal"
+	"github.com/googleapis/api-linter/internal/commonprotos"
+	"github.com/googleapis/api-linter/lint"
+	"github.com/googleapis/api-linter/rules"
+	"github.com/jhump/protoreflect/desc"
+	"google.golang.org/genproto/googleapis/api/serviceconfig"
+	dpb "google.golang.org/protobuf/types/descriptorpb"
+)
+
+// Request describes the files to lint and how to lint them.
+type Request struct {
+	// Sources provides the proto sources: the files to lint and any imports
+	// that are not found in DescriptorSets.
+	Sources fs.FS
+
+	// Files are the names of the files to lint within Sources. A name that
+	// starts with one of the ImportPaths is taken relative to it.
+	Files []string
+
+	// ImportPaths are the directories within Sources searched for the files
+	// and their imports, in order. If empty, the root of Sources is used.
+	ImportPaths []string
+
+	// DescriptorSets provide files, such as the output of
+	// `protoc --descriptor_set_out`, for resolving imports and references.
+	// They are not linted.
+	DescriptorSets []*dpb.FileDescriptorSet
+
+	// Configs are the linter configs, as read from a config file.
+	Configs lint.Configs
+
+	// EnabledRules and DisabledRules enable or disable rules by name, on top
+	// of Configs. Disabled rules win.
+	EnabledRules  []string
+	DisabledRules []string
+
+	// Rules are the rules to run. If nil, all of the linter's rules are run.
+	Rules lint.RuleRegistry
+
+	// IgnoreCommentDisables ignores the comments in the proto files that
+	// disable rules.
+	IgnoreCommentDisables bool
+
+	// DisableBundledProtos stops falling back to the bundled googleapis
+	// common protos (such as google/api/annotations.proto) for imports.
+	DisableBundledProtos bool
+
+	// Debug runs the linter in debug mode.
+	Debug bool
+
+	// Verbose runs the linter in verbose mode, which also reports
+	// informational problems.
+	Verbose bool
+
+	// ServiceConfig is the service config of the APIs, if any. Rules checking
+	// settings of the service config only run if it is set.
+	ServiceConfig *serviceconfig.Service
+
+	// Linter, if set, lints the parsed files. It is safe to reuse across
+	// requests. Rules, Configs, EnabledRules, DisabledRules,
+	// IgnoreCommentDisables, Debug, Verbose and ServiceConfig are then ignored, and the files of
+	// DescriptorSets are only used to resolve imports.
+	Linter *lint.Linter
+}
+
+// Result is the outcome of linting.
+type Result struct {
+	// Responses holds the problems found in each file. It is empty if the
+	// sources could not be parsed.
+	Responses []lint.Response
+
+	// ParseErrors holds the errors found while parsing the sources.
+	ParseErrors []ParseError
+}
+
+// ParseError is an error found while parsing the proto sources.
+type ParseError struct {
+	// Filename is the name of the file with the error.
+	Filename string `json:"filename" yaml:"filename"`
+
+	// Line and Column are the 1-based position of the error.
+	Line   int `json:"line_number" yaml:"line_number"`
+	Column int `json:"column_number" yaml:"column_number"`
+
+	// Message describes the error.
+	Message string `json:"message" yaml:"message"`
+}
+
+// Error returns the error in the `file:line:column: message` form used by
+// protoc.
+func (e ParseError) Error() string {
+	return fmt.Sprintf("%s:%d:%d: %s", e.Filename, e.Line, e.Column, e.Message)
+}
+
+func newParseError(err reporter.ErrorWithPos) ParseError {
+	pos := err.GetPosition()
+	return ParseError{
+		Filename: pos.Filename,
+		Line:     pos.Line,
+		Column:   pos.Col,
+		Message:  err.Unwrap().Error(),
+	}
+}
+
+// MapSources returns sources holding the given file contents, keyed by file
+// name.
+func MapSources(files map[string]string) fs.FS {
+	sources := fstest.MapFS{}
+	for name, content := range files {
+		sources[path.Clean(name)] = &fstest.MapFile{Data: []byte(content)}
+	}
+	return sources
+}
+
+// Lint parses the requested files and lints them.
+//
+// Errors in the proto sources, including unresolved imports, are reported in
+// the result's ParseErrors; the returned error is reserved for problems with
+// the request itself (such as missing files or invalid descriptor sets), rule
+// failures, and the context being done.
+func Lint(ctx context.Context, req Request) (*Result, error) {
+	descs, err := createFileDescriptors(req.DescriptorSets)
+	if err != nil {
+		return nil, err
+	}
+
+	// Parse the files.
+	var parseErrors []ParseError
+	var mu sync.Mutex
+	parser := internal.Parser{
+		ImportPaths: req.ImportPaths,
+		Accessor: func(name string) (io.ReadCloser, error) {
+			if req.Sources == nil {
+				return nil, fs.ErrNotExist
+			}
+			return req.Sources.Open(path.Clean(filepath.ToSlash(name)))
+		},
+		LookupImport: func(name string) (*desc.FileDescriptor, error) {
+			if f, found := descs[name]; found {
+				return f, nil
+			}
+			if !req.DisableBundledProtos {
+				return commonprotos.Lookup(name)
+			}
+			return nil, fmt.Errorf("%q is not found", name)
+		},
+		ErrorReporter: func(err reporter.ErrorWithPos) error {
+			mu.Lock()
+			parseErrors = append(parseErrors, newParseError(err))
+			mu.Unlock()
+			// Continue parsing to report every error.
+			return nil
+		},
+		Context: ctx,
+	}
+	fds, err := parser.ParseFiles(resolveFilenames(req.ImportPaths, req.Files)...)
+	if err != nil {
+		if errors.Is(err, reporter.ErrInvalidSource) && len(parseErrors) > 0 {
+			return &Result{ParseErrors: parseErrors}, nil
+		}
+		// Unresolvable imports are not sent to the reporter.
+		var errWithPos reporter.ErrorWithPos
+		if errors.As(err, &errWithPos) {
+			return &Result{ParseErrors: append(parseErrors, newParseError(errWithPos))}, nil
+		}
+		return nil, err
+	}
+
+	// Lint the files.
+	l := req.Linter
+	if l == nil {
+		if l, err = newLinter(req, descs); err != nil {
+			return nil, err
+		}
+	}
+	responses, err := l.LintProtosContext(ctx, fds...)
+	if err != nil {
+		return nil, err
+	}
+	return &Result{Responses: responses}, nil
+}
+
+// newLinter creates the linter described by the request.
+func newLinter(req Request, descs map[string]*desc.FileDescriptor) (*lint.Linter, error) {
+	registry := req.Rules
+	if registry == nil {
+		registry = lint.NewRuleRegistry()
+		if err := rules.Add(registry); err != nil {
+			return nil, err
+		}
+	}
+	configs := append(append(lint.Configs{}, req.Configs...),
+		lint.Config{EnabledRules: req.EnabledRules},
+		lint.Config{DisabledRules: req.DisabledRules},
+	)
+	return lint.New(registry, configs,
+		lint.Debug(req.Debug),
+		lint.Verbose(req.Verbose),
+		lint.IgnoreCommentDisables(req.IgnoreCommentDisables),
+		lint.LookupFiles(sortedFiles(descs)...),
+		lint.ServiceConfig(req.ServiceConfig),
+	), nil
+}
+
+// sortedFiles returns the values of the given map, sorted by file name, so
+// that lookups across files do not depend on map order.
+func sortedFiles(descs map[string]*desc.FileDescriptor) []*desc.FileDescriptor {
+	names := make([]string, 0, len(descs))
+	for name := range descs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	files := make([]*desc.FileDescriptor, 0, len(names))
+	for _, name := range names {
+		files = append(files, descs[name])
+	}
+	return files
+}
+
+// createFileDescriptors builds the files of the given descriptor sets.
+func createFileDescriptors(sets []*dpb.FileDescriptorSet) (map[string]*desc.FileDescriptor, error) {
+	var files []*dpb.FileDescriptorProto
+	for _, set := range sets {
+		files = append(files, set.GetFile()...)
+	}
+	if len(files) == 0 {
+		return map[string]*desc.FileDescriptor{}, nil
+	}
+	return desc.CreateFileDescriptors(files)
+}
+
+// resolveFilenames makes the given file names relative to the first import
+// path that contains them.
+func resolveFilenames(importPaths, filenames []string) []string {
+	resolved := make([]string, 0, len(filenames))
+	for _, name := range filenames {
+		name = path.Clean(filepath.ToSlash(name))
+		for _, importPath := range importPaths {
+			prefix := path.Clean(filepath.ToSlash(importPath)) + "/"
+			if prefix != "./" && strings.HasPrefix(name, prefix) {
+				name = strings.TrimPrefix(name, prefix)
+				break
+			}
+		}
+		resolved = append(resolved, name)
+	}
+	return resolved
+}
@@ -0,0 +1,240 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apilinter
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/googleapis/api-linter/lint"
+	dpb "google.golang.org/protobuf/types/descriptorpb"
+)
+
+const bookProto = `
+syntax = "proto3";
+
+package test;
+
+message Book {
+  string Title = 1;
+}
+`
+
+func problemRules(result *Result) map[lint.RuleName]bool {
+	rules := map[lint.RuleName]bool{}
+	for _, r := range result.Responses {
+		for _, p := range r.Problems {
+			rules[p.RuleID] = true
+		}
+	}
+	return rules
+}
+
+func TestLint(t *testing.T) {
+	result, err := Lint(context.Background(), Request{
+		Sources: MapSources(map[string]string{"test/book.proto": bookProto}),
+		Files:   []string{"test/book.proto"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.ParseErrors) != 0 {
+		t.Errorf("Got parse errors %v; want none", result.ParseErrors)
+	}
+	if len(result.Responses) != 1 || result.Responses[0].FilePath != "test/book.proto" {
+		t.Fatalf("Got responses %v; want one for test/book.proto", result.Responses)
+	}
+	if rules := problemRules(result); !rules["core::0140::lower-snake"] {
+		t.Errorf("Got problems from %v; want core::0140::lower-snake", rules)
+	}
+}
+
+func TestLint_ImportPaths(t *testing.T) {
+	result, err := Lint(context.Background(), Request{
+		Sources: MapSources(map[string]string{
+			"protos/test/book.proto": bookProto,
+			"protos/test/shelf.proto": `
+				syntax = "proto3";
+				package test;
+				import "test/book.proto";
+				message Shelf { repeated Book books = 1; }
+			`,
+		}),
+		ImportPaths: []string{"protos"},
+		Files:       []string{"protos/test/shelf.proto"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Responses) != 1 || result.Responses[0].FilePath != "test/shelf.proto" {
+		t.Errorf("Got responses %v; want one for test/shelf.proto", result.Responses)
+	}
+}
+
+func TestLint_DisabledRules(t *testing.T) {
+	result, err := Lint(context.Background(), Request{
+		Sources:       MapSources(map[string]string{"book.proto": bookProto}),
+		Files:         []string{"book.proto"},
+		DisabledRules: []string{"core::0140::lower-snake"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rules := problemRules(result); rules["core::0140::lower-snake"] {
+		t.Errorf("Got problems from disabled rule core::0140::lower-snake")
+	}
+}
+
+func TestLint_Linter(t *testing.T) {
+	result, err := Lint(context.Background(), Request{
+		Sources: MapSources(map[string]string{"book.proto": bookProto}),
+		Files:   []string{"book.proto"},
+		Linter:  lint.New(lint.NewRuleRegistry(), nil),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rules := problemRules(result); len(rules) != 0 {
+		t.Errorf("Got problems from %v; want none from a linter without rules", rules)
+	}
+}
+
+func TestLint_ParseErrors(t *testing.T) {
+	result, err := Lint(context.Background(), Request{
+		Sources: MapSources(map[string]string{"book.proto": `
+			syntax = "proto3";
+			message Book {
+			  string title = 1
+			}
+		`}),
+		Files: []string{"book.proto"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Responses) != 0 {
+		t.Errorf("Got responses %v; want none", result.Responses)
+	}
+	if len(result.ParseErrors) != 1 {
+		t.Fatalf("Got parse errors %v; want one", result.ParseErrors)
+	}
+	if got := result.ParseErrors[0]; got.Filename != "book.proto" || got.Line != 5 || got.Message == "" {
+		t.Errorf("Got parse error %#v; want one in book.proto at line 5", got)
+	}
+}
+
+func TestLint_DescriptorSets(t *testing.T) {
+	dep := &dpb.FileDescriptorProto{
+		Name:    strPtr("shared/book.proto"),
+		Package: strPtr("shared"),
+		Syntax:  strPtr("proto3"),
+		MessageType: []*dpb.DescriptorProto{
+			{Name: strPtr("Book")},
+		},
+	}
+	sources := MapSources(map[string]string{"shelf.proto": `
+		syntax = "proto3";
+		package test;
+		import "shared/book.proto";
+		message Shelf { repeated shared.Book books = 1; }
+	`})
+	result, err := Lint(context.Background(), Request{
+		Sources: sources,
+		Files:   []string{"shelf.proto"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.ParseErrors) != 1 || result.ParseErrors[0].Line != 4 {
+		t.Errorf("Got parse errors %v without the descriptor set; want the unresolved import", result.ParseErrors)
+	}
+	result, err = Lint(context.Background(), Request{
+		Sources:        sources,
+		Files:          []string{"shelf.proto"},
+		DescriptorSets: []*dpb.FileDescriptorSet{{File: []*dpb.FileDescriptorProto{dep}}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.ParseErrors) != 0 || len(result.Responses) != 1 {
+		t.Errorf("Got result %v; want one response and no parse errors", result)
+	}
+}
+
+func TestLint_BundledProtos(t *testing.T) {
+	sources := MapSources(map[string]string{"book.proto": `
+		syntax = "proto3";
+		package test;
+		import "google/api/field_behavior.proto";
+		message Book { string title = 1 [(google.api.field_behavior) = REQUIRED]; }
+	`})
+	result, err := Lint(context.Background(), Request{Sources: sources, Files: []string{"book.proto"}})
+	if err != nil || len(result.ParseErrors) != 0 {
+		t.Errorf("Lint() returned %v, %v; want the import resolved from the bundled protos", result.ParseErrors, err)
+	}
+	result, err = Lint(context.Background(), Request{Sources: sources, Files: []string{"book.proto"}, DisableBundledProtos: true})
+	if err != nil || len(result.ParseErrors) == 0 {
+		t.Errorf("Lint() with DisableBundledProtos returned %v; want an unresolved import", err)
+	}
+}
+
+func TestLint_MissingFile(t *testing.T) {
+	if _, err := Lint(context.Background(), Request{Sources: MapSources(nil), Files: []string{"book.proto"}}); err == nil {
+		t.Errorf("Lint() of a missing file succeeded; want an error")
+	}
+}
+
+func TestLint_Canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := Lint(ctx, Request{
+		Sources: MapSources(map[string]string{"book.proto": bookProto}),
+		Files:   []string{"book.proto"},
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Lint() returned %v; want %v", err, context.Canceled)
+	}
+}
+
+func TestParseError_Error(t *testing.T) {
+	err := ParseError{Filename: "book.proto", Line: 3, Column: 7, Message: "syntax error"}
+	if got, want := err.Error(), "book.proto:3:7: syntax error"; got != want {
+		t.Errorf("Error() = %q; want %q", got, want)
+	}
+}
+
+func strPtr(s string) *string {
+	return &s
+}
+
+func TestSortedFiles(t *testing.T) {
+	var files []*dpb.FileDescriptorProto
+	for _, name := range []string{"c.proto", "a.proto", "b.proto"} {
+		files = append(files, &dpb.FileDescriptorProto{Name: strPtr(name), Syntax: strPtr("proto3")})
+	}
+	descs, err := createFileDescriptors([]*dpb.FileDescriptorSet{{File: files}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, f := range sortedFiles(descs) {
+		got = append(got, f.GetName())
+	}
+	if diff := cmp.Diff([]string{"a.proto", "b.proto", "c.proto"}, got); diff != "" {
+		t.Errorf("sortedFiles() mismatch (-want +got):\n%s", diff)
+	}
+}
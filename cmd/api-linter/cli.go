@@ -15,25 +15,30 @@
 package main
 
 import (
-	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
+	"sort"
 	"strings"
 	"sync"
+	"time"
 
+	"github.com/bmatcuk/doublestar/v4"
+	"github.com/googleapis/api-linter/cmd/internal/format"
 	"github.com/googleapis/api-linter/internal"
+	"github.com/googleapis/api-linter/internal/commonprotos"
 	"github.com/googleapis/api-linter/lint"
 	"github.com/jhump/protoreflect/desc"
 	"github.com/jhump/protoreflect/desc/protoparse"
 	"github.com/spf13/pflag"
+	"google.golang.org/genproto/googleapis/api/serviceconfig"
 	"google.golang.org/protobuf/proto"
 	dpb "google.golang.org/protobuf/types/descriptorpb"
-	"gopkg.in/yaml.v3"
 )
 
 type cli struct {
 	ConfigPath                string
+	ServiceConfigPath         string
 	FormatType                string
 	OutputPath                string
 	ExitStatusOnLintFailure   bool
@@ -41,11 +46,22 @@ type cli struct {
 	ProtoImportPaths          []string
 	ProtoFiles                []string
 	ProtoDescPath             []string
+	LintDescriptorSets        bool
+	GRPCReflectionAddr        string
+	GRPCReflectionTLS         bool
+	GRPCReflectionCAFile      string
 	EnabledRules              []string
 	DisabledRules             []string
 	ListRulesFlag             bool
 	DebugFlag                 bool
+	VerboseFlag               bool
 	IgnoreCommentDisablesFlag bool
+	DisableBundledProtos      bool
+	ContinueOnParseErrors     bool
+	FailOnInternalErrors      bool
+	ServeAddress              string
+	ServeRequestTimeout       time.Duration
+	ServeMaxRequestBytes      int64
 }
 
 // ExitForLintFailure indicates that a problem was found during linting.
@@ -53,35 +69,82 @@ type cli struct {
 //lint:ignore ST1012 modifying this variable name is a breaking change.
 var ExitForLintFailure = errors.New("found problems during linting")
 
+// ErrParseFailure indicates that some of the files to lint could not be
+// parsed. The errors are reported as problems of the
+// lint.ParseErrorRuleName rule.
+var ErrParseFailure = errors.New("found errors during parsing")
+
+// ErrInternalFailure indicates that some rules failed while linting, and that
+// the run was asked to fail because of it. The failures are reported as
+// problems of the lint.InternalErrorRuleName rule.
+var ErrInternalFailure = errors.New("rules failed during linting")
+
 func newCli(args []string) *cli {
+	return parseCli("api-linter", args, false)
+}
+
+// newServeCli is like newCli, but also accepts the flags of the serve
+// command.
+func newServeCli(args []string) *cli {
+	return parseCli("api-linter serve", args, true)
+}
+
+func parseCli(name string, args []string, serve bool) *cli {
 	// Define flag variables.
 	var cfgFlag string
+	var serviceConfigFlag string
 	var fmtFlag string
 	var outFlag string
 	var setExitStatusOnLintFailure bool
 	var versionFlag bool
 	var protoImportFlag []string
 	var protoDescFlag []string
+	var lintDescFlag bool
+	var grpcReflectionFlag string
+	var grpcReflectionTLSFlag bool
+	var grpcReflectionCAFileFlag string
 	var ruleEnableFlag []string
 	var ruleDisableFlag []string
 	var listRulesFlag bool
 	var debugFlag bool
+	var verboseFlag bool
 	var ignoreCommentDisablesFlag bool
+	var disableBundledProtosFlag bool
+	var continueOnParseErrorsFlag bool
+	var failOnInternalErrorsFlag bool
+	var addressFlag string
+	var requestTimeoutFlag time.Duration
+	var maxRequestBytesFlag int64
 
 	// Register flag variables.
-	fs := pflag.NewFlagSet("api-linter", pflag.ExitOnError)
+	fs := pflag.NewFlagSet(name, pflag.ExitOnError)
 	fs.StringVar(&cfgFlag, "config", "", "The linter config file.")
+	fs.StringVar(&serviceConfigFlag, "service-config", "", "The service config (google.api.Service) file of the APIs, in YAML or JSON.\nRules checking settings of the service config only run if it is given.")
 	fs.StringVar(&fmtFlag, "output-format", "", "The format of the linting results.\nSupported formats include \"yaml\", \"json\",\"github\" and \"summary\" table.\nYAML is the default.")
 	fs.StringVarP(&outFlag, "output-path", "o", "", "The output file path.\nIf not given, the linting results will be printed out to STDOUT.")
 	fs.BoolVar(&setExitStatusOnLintFailure, "set-exit-status", false, "Return exit status 1 when lint errors are found.")
 	fs.BoolVar(&versionFlag, "version", false, "Print version and exit.")
 	fs.StringArrayVarP(&protoImportFlag, "proto-path", "I", nil, "The folder for searching proto imports.\nMay be specified multiple times; directories will be searched in order.\nThe current working directory is always used.")
 	fs.StringArrayVar(&protoDescFlag, "descriptor-set-in", nil, "The file containing a FileDescriptorSet for searching proto imports.\nMay be specified multiple times.")
+	fs.BoolVar(&lintDescFlag, "lint-descriptor-sets", false, "Lint the files in the descriptor-set-in files instead of proto sources.\nPositional arguments, if given, select the files to lint by name or glob pattern.")
+	fs.StringVar(&grpcReflectionFlag, "grpc-reflection", "", "Lint the services of the gRPC server at the given address, fetched through\nthe server reflection protocol, instead of proto sources.\nPositional arguments, if given, name the services or other symbols whose files\nare linted.")
+	fs.BoolVar(&grpcReflectionTLSFlag, "grpc-reflection-tls", false, "Connect to the grpc-reflection server with TLS, verifying its certificate\nwith the system roots.")
+	fs.StringVar(&grpcReflectionCAFileFlag, "grpc-reflection-ca-file", "", "A PEM file of the CA certificates that verify the certificate of the\ngrpc-reflection server, instead of the system roots. Implies grpc-reflection-tls.")
 	fs.StringArrayVar(&ruleEnableFlag, "enable-rule", nil, "Enable a rule with the given name.\nMay be specified multiple times.")
 	fs.StringArrayVar(&ruleDisableFlag, "disable-rule", nil, "Disable a rule with the given name.\nMay be specified multiple times.")
 	fs.BoolVar(&listRulesFlag, "list-rules", false, "Print the rules and exit.  Honors the output-format flag.")
-	fs.BoolVar(&debugFlag, "debug", false, "Run in debug mode. Panics will print stack.")
+	fs.BoolVar(&debugFlag, "debug", false, "Run in debug mode. Rule failures will include the stack.")
+	fs.BoolVar(&verboseFlag, "verbose", false, "Run in verbose mode. Informational problems, such as the implicit\nrouting headers of methods, will also be reported.")
 	fs.BoolVar(&ignoreCommentDisablesFlag, "ignore-comment-disables", false, "If set to true, disable comments will be ignored.\nThis is helpful when strict enforcement of AIPs are necessary and\nproto definitions should not be able to disable checks.")
+	fs.BoolVar(&disableBundledProtosFlag, "disable-bundled-protos", false, "Do not fall back to the bundled googleapis common protos\n(e.g. google/api/annotations.proto) for imports that are not found.")
+
+	fs.BoolVar(&continueOnParseErrorsFlag, "continue-on-parse-errors", false, "Lint the files that were parsed successfully even if other files have errors.\nParse errors are reported as problems either way.")
+	fs.BoolVar(&failOnInternalErrorsFlag, "fail-on-internal-errors", false, "Return an error when a rule fails (for example, by panicking).\nFailures are reported as problems either way.")
+	if serve {
+		fs.StringVar(&addressFlag, "address", "localhost:8080", "The address that the server listens on.")
+		fs.DurationVar(&requestTimeoutFlag, "request-timeout", 30*time.Second, "The maximum time spent on a request.")
+		fs.Int64Var(&maxRequestBytesFlag, "max-request-bytes", 4<<20, "The maximum size of a request body.")
+	}
 
 	// Parse flags.
 	err := fs.Parse(args)
@@ -91,18 +154,30 @@ func newCli(args []string) *cli {
 
 	return &cli{
 		ConfigPath:                cfgFlag,
+		ServiceConfigPath:         serviceConfigFlag,
 		FormatType:                fmtFlag,
 		OutputPath:                outFlag,
 		ExitStatusOnLintFailure:   setExitStatusOnLintFailure,
 		ProtoImportPaths:          append(protoImportFlag, "."),
 		ProtoDescPath:             protoDescFlag,
+		LintDescriptorSets:        lintDescFlag,
+		GRPCReflectionAddr:        grpcReflectionFlag,
+		GRPCReflectionTLS:         grpcReflectionTLSFlag,
+		GRPCReflectionCAFile:      grpcReflectionCAFileFlag,
 		EnabledRules:              ruleEnableFlag,
 		DisabledRules:             ruleDisableFlag,
 		ProtoFiles:                fs.Args(),
 		VersionFlag:               versionFlag,
 		ListRulesFlag:             listRulesFlag,
 		DebugFlag:                 debugFlag,
+		VerboseFlag:               verboseFlag,
 		IgnoreCommentDisablesFlag: ignoreCommentDisablesFlag,
+		DisableBundledProtos:      disableBundledProtosFlag,
+		ContinueOnParseErrors:     continueOnParseErrorsFlag,
+		FailOnInternalErrors:      failOnInternalErrorsFlag,
+		ServeAddress:              addressFlag,
+		ServeRequestTimeout:       requestTimeoutFlag,
+		ServeMaxRequestBytes:      maxRequestBytesFlag,
 	}
 }
 
@@ -110,6 +185,7 @@ func (c *cli) lint(rules lint.RuleRegistry, configs lint.Configs) error {
 	// Print version and exit if asked.
 	if c.VersionFlag {
 		fmt.Printf("api-linter %s\n", internal.Version)
+		fmt.Printf("bundled common protos: %s\n", commonprotos.Version())
 		return nil
 	}
 
@@ -117,15 +193,111 @@ func (c *cli) lint(rules lint.RuleRegistry, configs lint.Configs) error {
 		return outputRules(c.FormatType)
 	}
 
+	if strings.EqualFold(c.FormatType, "dot") {
+		return fmt.Errorf("the dot output format is only supported by `api-linter resources`")
+	}
+
 	// Pre-check if there are files to lint.
-	if len(c.ProtoFiles) == 0 {
+	if c.LintDescriptorSets && c.GRPCReflectionAddr != "" {
+		return fmt.Errorf("--lint-descriptor-sets and --grpc-reflection cannot be used together")
+	}
+	switch {
+	case c.GRPCReflectionAddr != "":
+		// The files to lint come from the server.
+	case c.LintDescriptorSets:
+		if len(c.ProtoDescPath) == 0 {
+			return fmt.Errorf("no descriptor set to lint")
+		}
+	case len(c.ProtoFiles) == 0:
 		return fmt.Errorf("no file to lint")
 	}
+	configs, err := c.configs(configs)
+	if err != nil {
+		return err
+	}
+	serviceConfig, err := c.serviceConfig()
+	if err != nil {
+		return err
+	}
+	// Load the descriptor sets, which are used both to resolve imports and
+	// to resolve references across files.
+	descs, err := loadFileDescriptors(c.ProtoDescPath...)
+	if err != nil {
+		return err
+	}
+	// Parse the proto files to lint, or pick them from the descriptor sets
+	// or the gRPC server.
+	var fd []*desc.FileDescriptor
+	if c.GRPCReflectionAddr != "" {
+		fd, descs, err = c.reflectedDescriptorsToLint(descs)
+	} else if c.LintDescriptorSets {
+		fd, err = c.descriptorsToLint(descs)
+	} else {
+		fd, err = c.parseProtos(descs)
+	}
+	// Report errors in the proto sources as problems, and lint the files
+	// that were parsed successfully if asked.
+	var parseFailures *parseErrors
+	if errors.As(err, &parseFailures) {
+		fd, err = nil, nil
+		if c.ContinueOnParseErrors {
+			fd = parseFailures.parsed
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	// Create a linter to lint the file descriptors.
+	l := lint.New(rules, configs,
+		lint.Debug(c.DebugFlag),
+		lint.Verbose(c.VerboseFlag),
+		lint.IgnoreCommentDisables(c.IgnoreCommentDisablesFlag),
+		lint.LookupFiles(sortedFileDescriptors(descs)...),
+		lint.ServiceConfig(serviceConfig),
+	)
+	results, err := l.LintProtos(fd...)
+	if err != nil {
+		return err
+	}
+	if parseFailures != nil {
+		results = append(parseFailures.responses(), results...)
+	}
+
+	// Print the results. YAML format is the default.
+	b, err := format.Responses(c.FormatType, results)
+	if err != nil {
+		return err
+	}
+	if err := c.writeOutput(b); err != nil {
+		return err
+	}
+
+	// Parse errors always fail the run.
+	if parseFailures != nil {
+		return ErrParseFailure
+	}
+	if c.FailOnInternalErrors && anyProblemsOf(results, lint.InternalErrorRuleName) {
+		return ErrInternalFailure
+	}
+
+	// Return error on lint failure which subsequently
+	// exits with a non-zero status code
+	if c.ExitStatusOnLintFailure && anyProblems(results) {
+		return ExitForLintFailure
+	}
+
+	return nil
+}
+
+// configs returns the given configs followed by the config file and the
+// rules enabled and disabled on the command line.
+func (c *cli) configs(configs lint.Configs) (lint.Configs, error) {
 	// Read linter config and append it to the default.
 	if c.ConfigPath != "" {
 		config, err := lint.ReadConfigsFromFile(c.ConfigPath)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		configs = append(configs, config...)
 	}
@@ -137,24 +309,49 @@ func (c *cli) lint(rules lint.RuleRegistry, configs lint.Configs) error {
 	configs = append(configs, lint.Config{
 		DisabledRules: c.DisabledRules,
 	})
-	// Prepare proto import lookup.
-	fs, err := loadFileDescriptors(c.ProtoDescPath...)
-	if err != nil {
-		return err
+	return configs, nil
+}
+
+// serviceConfig reads the service config file, if any.
+func (c *cli) serviceConfig() (*serviceconfig.Service, error) {
+	if c.ServiceConfigPath == "" {
+		return nil, nil
 	}
+	return lint.ReadServiceConfigFromFile(c.ServiceConfigPath)
+}
+
+// parseProtos parses the proto files given on the command line into
+// `protoreflect` file descriptors, resolving imports from the given
+// descriptor set files when they are not found on the proto path, and then
+// from the bundled common protos unless those are disabled.
+//
+// Errors in the proto sources are returned as a *parseErrors.
+func (c *cli) parseProtos(fs map[string]*desc.FileDescriptor) ([]*desc.FileDescriptor, error) {
+	// Prepare proto import lookup.
+	var usedBundled bool
 	lookupImport := func(name string) (*desc.FileDescriptor, error) {
 		if f, found := fs[name]; found {
 			return f, nil
 		}
+		if !c.DisableBundledProtos {
+			if f, err := commonprotos.Lookup(name); err == nil {
+				usedBundled = true
+				return f, nil
+			}
+		}
 		return nil, fmt.Errorf("%q is not found", name)
 	}
+	defer func() {
+		if usedBundled {
+			fmt.Fprintf(os.Stderr, "Resolved imports from the bundled common protos (%s).\n", commonprotos.Version())
+		}
+	}()
 	var errorsWithPos []protoparse.ErrorWithPos
 	var lock sync.Mutex
 	// Parse proto files into `protoreflect` file descriptors.
-	p := protoparse.Parser{
-		ImportPaths:           c.ProtoImportPaths,
-		IncludeSourceCodeInfo: true,
-		LookupImport:          lookupImport,
+	p := internal.Parser{
+		ImportPaths:  c.ProtoImportPaths,
+		LookupImport: lookupImport,
 		ErrorReporter: func(errorWithPos protoparse.ErrorWithPos) error {
 			// Protoparse isn't concurrent right now but just to be safe for the future.
 			lock.Lock()
@@ -167,32 +364,124 @@ func (c *cli) lint(rules lint.RuleRegistry, configs lint.Configs) error {
 	// Resolve file absolute paths to relative ones.
 	protoFiles, err := protoparse.ResolveFilenames(c.ProtoImportPaths, c.ProtoFiles...)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	fd, err := p.ParseFiles(protoFiles...)
 	if err != nil {
 		if err == protoparse.ErrInvalidSource {
 			if len(errorsWithPos) == 0 {
-				return errors.New("got protoparse.ErrInvalidSource but no ErrorWithPos errors")
+				return nil, errors.New("got protoparse.ErrInvalidSource but no ErrorWithPos errors")
 			}
-			// TODO: There's multiple ways to deal with this but this prints all the errors at least
-			errStrings := make([]string, len(errorsWithPos))
-			for i, errorWithPos := range errorsWithPos {
-				errStrings[i] = errorWithPos.Error()
+			perr := &parseErrors{errs: errorsWithPos}
+			for _, f := range fd {
+				if f != nil {
+					perr.parsed = append(perr.parsed, f)
+				}
 			}
-			return errors.New(strings.Join(errStrings, "\n"))
+			return nil, perr
 		}
-		return err
+		// Unresolvable imports are returned without being reported.
+		var errorWithPos protoparse.ErrorWithPos
+		if errors.As(err, &errorWithPos) {
+			return nil, &parseErrors{errs: []protoparse.ErrorWithPos{errorWithPos}}
+		}
+		return nil, err
 	}
+	return fd, nil
+}
 
-	// Create a linter to lint the file descriptors.
-	l := lint.New(rules, configs, lint.Debug(c.DebugFlag), lint.IgnoreCommentDisables(c.IgnoreCommentDisablesFlag))
-	results, err := l.LintProtos(fd...)
+// parseErrors holds the errors found in the proto sources, and the files that
+// were parsed successfully nonetheless.
+type parseErrors struct {
+	errs   []protoparse.ErrorWithPos
+	parsed []*desc.FileDescriptor
+}
+
+// Error returns every error, one per line.
+func (e *parseErrors) Error() string {
+	errStrings := make([]string, len(e.errs))
+	for i, errorWithPos := range e.errs {
+		errStrings[i] = errorWithPos.Error()
+	}
+	return strings.Join(errStrings, "\n")
+}
+
+// responses returns the errors as problems of the lint.ParseErrorRuleName
+// rule, grouped by file in the order the files were first reported.
+func (e *parseErrors) responses() []lint.Response {
+	var responses []lint.Response
+	index := map[string]int{}
+	for _, errorWithPos := range e.errs {
+		pos := errorWithPos.GetPosition()
+		i, found := index[pos.Filename]
+		if !found {
+			i = len(responses)
+			index[pos.Filename] = i
+			responses = append(responses, lint.Response{FilePath: pos.Filename})
+		}
+		message := errorWithPos.Error()
+		if cause := errors.Unwrap(errorWithPos); cause != nil {
+			message = cause.Error()
+		}
+		responses[i].Problems = append(responses[i].Problems, lint.NewParseErrorProblem(pos.Filename, pos.Line, pos.Col, message))
+	}
+	return responses
+}
+
+// descriptorsToLint returns the files in the descriptor sets that should be
+// linted: the ones matching the positional arguments, or all of them if none
+// were given. Their SourceCodeInfo, if any, provides the problem locations.
+func (c *cli) descriptorsToLint(fs map[string]*desc.FileDescriptor) ([]*desc.FileDescriptor, error) {
+	all := sortedFileDescriptors(fs)
+	if len(c.ProtoFiles) == 0 {
+		return all, nil
+	}
+	selected := map[string]bool{}
+	for _, pattern := range c.ProtoFiles {
+		var matched bool
+		for _, f := range all {
+			if ok, _ := doublestar.Match(pattern, f.GetName()); ok {
+				matched = true
+				selected[f.GetName()] = true
+			}
+		}
+		if !matched {
+			return nil, fmt.Errorf("%q does not match any file in the descriptor sets", pattern)
+		}
+	}
+	var answer []*desc.FileDescriptor
+	for _, f := range all {
+		if selected[f.GetName()] {
+			answer = append(answer, f)
+		}
+	}
+	return answer, nil
+}
+
+// reflectedDescriptorsToLint fetches the files of the gRPC server. It returns
+// the files declaring the symbols named by the positional arguments, or the
+// services if none were given, and the given descriptors extended with all
+// fetched files.
+func (c *cli) reflectedDescriptorsToLint(fs map[string]*desc.FileDescriptor) ([]*desc.FileDescriptor, map[string]*desc.FileDescriptor, error) {
+	creds, err := reflectionCredentials(c.GRPCReflectionTLS, c.GRPCReflectionCAFile)
 	if err != nil {
-		return err
+		return nil, nil, err
+	}
+	files, all, err := loadReflectedFileDescriptors(c.GRPCReflectionAddr, creds, c.ProtoFiles)
+	if err != nil {
+		return nil, nil, err
+	}
+	for name, f := range fs {
+		if _, found := all[name]; !found {
+			all[name] = f
+		}
 	}
+	return files, all, nil
+}
 
-	// Determine the output for writing the results.
+// writeOutput writes the given bytes to the output path, or to STDOUT if no
+// output path was given.
+func (c *cli) writeOutput(b []byte) error {
 	// Stdout is the default output.
 	w := os.Stdout
 	if c.OutputPath != "" {
@@ -203,33 +492,31 @@ func (c *cli) lint(rules lint.RuleRegistry, configs lint.Configs) error {
 		}
 		defer w.Close()
 	}
+	_, err := w.Write(b)
+	return err
+}
 
-	// Determine the format for printing the results.
-	// YAML format is the default.
-	marshal := getOutputFormatFunc(c.FormatType)
-
-	// Print the results.
-	b, err := marshal(results)
-	if err != nil {
-		return err
-	}
-	if _, err = w.Write(b); err != nil {
-		return err
-	}
-
-	// Return error on lint failure which subsequently
-	// exits with a non-zero status code
-	if c.ExitStatusOnLintFailure && anyProblems(results) {
-		return ExitForLintFailure
+// anyProblems returns true if any lint problem was found. Rule failures,
+// reported as internal errors, and informational problems are not lint
+// problems.
+func anyProblems(results []lint.Response) bool {
+	for i := range results {
+		for _, p := range results[i].Problems {
+			if p.RuleID != lint.InternalErrorRuleName && !p.Informational {
+				return true
+			}
+		}
 	}
-
-	return nil
+	return false
 }
 
-func anyProblems(results []lint.Response) bool {
+// anyProblemsOf returns true if any problem of the given rule was found.
+func anyProblemsOf(results []lint.Response, rule lint.RuleName) bool {
 	for i := range results {
-		if len(results[i].Problems) > 0 {
-			return true
+		for _, p := range results[i].Problems {
+			if p.RuleID == rule {
+				return true
+			}
 		}
 	}
 	return false
@@ -247,6 +534,21 @@ func loadFileDescriptors(filePaths ...string) (map[string]*desc.FileDescriptor,
 	return desc.CreateFileDescriptors(fds)
 }
 
+// sortedFileDescriptors returns the values of the given map, sorted by file
+// name.
+func sortedFileDescriptors(fs map[string]*desc.FileDescriptor) []*desc.FileDescriptor {
+	names := make([]string, 0, len(fs))
+	for name := range fs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	answer := make([]*desc.FileDescriptor, 0, len(names))
+	for _, name := range names {
+		answer = append(answer, fs[name])
+	}
+	return answer
+}
+
 func readFileDescriptorSet(filePath string) (*dpb.FileDescriptorSet, error) {
 	in, err := os.ReadFile(filePath)
 	if err != nil {
@@ -258,36 +560,3 @@ func readFileDescriptorSet(filePath string) (*dpb.FileDescriptorSet, error) {
 	}
 	return fs, nil
 }
-
-var outputFormatFuncs = map[string]formatFunc{
-	"yaml": yaml.Marshal,
-	"yml":  yaml.Marshal,
-	"json": json.Marshal,
-	"github": func(i interface{}) ([]byte, error) {
-		switch v := i.(type) {
-		case []lint.Response:
-			return formatGitHubActionOutput(v), nil
-		default:
-			return json.Marshal(v)
-		}
-	},
-	"summary": func(i interface{}) ([]byte, error) {
-		switch v := i.(type) {
-		case []lint.Response:
-			return printSummaryTable(v)
-		case listedRules:
-			return v.printSummaryTable()
-		default:
-			return json.Marshal(v)
-		}
-	},
-}
-
-type formatFunc func(interface{}) ([]byte, error)
-
-func getOutputFormatFunc(formatType string) formatFunc {
-	if f, found := outputFormatFuncs[strings.ToLower(formatType)]; found {
-		return f
-	}
-	return yaml.Marshal
-}
@@ -1,15 +1,22 @@
 package main
 
 import (
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/googleapis/api-linter/lint"
+	"github.com/jhump/protoreflect/desc"
 )
 
 func TestNewCli(t *testing.T) {
 	tests := []struct {
 		name      string
 		inputArgs []string
+		serve     bool
 		wantCli   *cli
 	}{
 		{
@@ -34,6 +41,21 @@ func TestNewCli(t *testing.T) {
 				ProtoFiles:       []string{"a.proto", "b.proto"},
 			},
 		},
+		{
+			name: "GRPCReflectionTLS",
+			inputArgs: []string{
+				"--grpc-reflection=localhost:443",
+				"--grpc-reflection-tls",
+				"--grpc-reflection-ca-file=ca.pem",
+			},
+			wantCli: &cli{
+				GRPCReflectionAddr:   "localhost:443",
+				GRPCReflectionTLS:    true,
+				GRPCReflectionCAFile: "ca.pem",
+				ProtoImportPaths:     []string{"."},
+				ProtoFiles:           []string{},
+			},
+		},
 		{
 			name: "ExitStatusOnLintFailure",
 			inputArgs: []string{
@@ -45,13 +67,104 @@ func TestNewCli(t *testing.T) {
 				ProtoFiles:              []string{},
 			},
 		},
+		{
+			name:  "ServeDefaults",
+			serve: true,
+			wantCli: &cli{
+				ProtoImportPaths:     []string{"."},
+				ProtoFiles:           []string{},
+				ServeAddress:         "localhost:8080",
+				ServeRequestTimeout:  30 * time.Second,
+				ServeMaxRequestBytes: 4 << 20,
+			},
+		},
+		{
+			name:  "ServeFlags",
+			serve: true,
+			inputArgs: []string{
+				"--address=:9000",
+				"--request-timeout=5s",
+				"--max-request-bytes=1024",
+			},
+			wantCli: &cli{
+				ProtoImportPaths:     []string{"."},
+				ProtoFiles:           []string{},
+				ServeAddress:         ":9000",
+				ServeRequestTimeout:  5 * time.Second,
+				ServeMaxRequestBytes: 1024,
+			},
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			gotCli := newCli(test.inputArgs)
+			newCliFunc := newCli
+			if test.serve {
+				newCliFunc = newServeCli
+			}
+			gotCli := newCliFunc(test.inputArgs)
 			if diff := cmp.Diff(gotCli, test.wantCli); diff != "" {
 				t.Errorf("newCli() mismatch (-want +got):\n%s", diff)
 			}
 		})
 	}
 }
+
+func TestInternalErrors(t *testing.T) {
+	rules := lint.NewRuleRegistry()
+	if err := rules.Register(191, &lint.FileRule{
+		Name: lint.NewRuleName(191, "panic"),
+		LintFile: func(*desc.FileDescriptor) []lint.Problem {
+			panic("boom")
+		},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	for _, test := range []struct {
+		name    string
+		args    []string
+		wantErr error
+	}{
+		{"Default", nil, nil},
+		{"SetExitStatus", []string{"--set-exit-status"}, nil},
+		{"FailOnInternalErrors", []string{"--fail-on-internal-errors"}, ErrInternalFailure},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			outPath := filepath.Join(t.TempDir(), "test.out")
+			args := append([]string{"-o=" + outPath, "internal/testdata/dummy.proto"}, test.args...)
+			if err := newCli(args).lint(rules, nil); err != test.wantErr {
+				t.Errorf("lint() returned %v; want %v", err, test.wantErr)
+			}
+			out, err := os.ReadFile(outPath)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !strings.Contains(string(out), "api-linter::internal-error") || !strings.Contains(string(out), "boom") {
+				t.Errorf("Got %s; want an internal error problem", out)
+			}
+		})
+	}
+}
+
+func TestInformationalProblems(t *testing.T) {
+	rules := lint.NewRuleRegistry()
+	if err := rules.Register(191, lint.VerboseOnly(&lint.FileRule{
+		Name: lint.NewRuleName(191, "informational"),
+		LintFile: func(f *desc.FileDescriptor) []lint.Problem {
+			return []lint.Problem{{Message: "informational", Descriptor: f}}
+		},
+	})); err != nil {
+		t.Fatal(err)
+	}
+	outPath := filepath.Join(t.TempDir(), "test.out")
+	args := []string{"--verbose", "--set-exit-status", "-o=" + outPath, "internal/testdata/dummy.proto"}
+	if err := newCli(args).lint(rules, nil); err != nil {
+		t.Errorf("lint() returned %v; want no error for informational problems", err)
+	}
+	out, err := os.ReadFile(outPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(out), "informational: true") {
+		t.Errorf("Got %s; want an informational problem", out)
+	}
+}
@@ -15,6 +15,7 @@
 package main
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
@@ -23,6 +24,12 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/googleapis/api-linter/internal"
+	"github.com/googleapis/api-linter/internal/commonprotos"
+	"github.com/googleapis/api-linter/lint"
+	"github.com/jhump/protoreflect/desc/protoparse"
+	"google.golang.org/protobuf/proto"
+	dpb "google.golang.org/protobuf/types/descriptorpb"
 )
 
 // Each case must be positive when the rule in test
@@ -157,27 +164,118 @@ func TestRules_DisabledByConfig(t *testing.T) {
 }
 
 func TestBuildErrors(t *testing.T) {
-	expected := []string{
-		"internal/testdata/build_errors.proto:8:1:",
-		"internal/testdata/build_errors.proto:13:1:",
+	outPath := filepath.Join(t.TempDir(), "test.out")
+	err := runCLI([]string{"--output-format=json", "-o=" + outPath, "internal/testdata/build_errors.proto"})
+	if err != ErrParseFailure {
+		t.Fatalf("runCLI() returned %v; want %v", err, ErrParseFailure)
 	}
-	err := runCLI([]string{"internal/testdata/build_errors.proto"})
-	if err == nil {
-		t.Fatal("expected build error for build_errors.proto")
+	got := readParseErrors(t, outPath)
+	want := []string{
+		"internal/testdata/build_errors.proto:8:1",
+		"internal/testdata/build_errors.proto:13:1",
 	}
-	actual := err.Error()
-	actualLines := strings.Split(strings.TrimSpace(actual), "\n")
-	for idx, line := range actualLines {
-		if idx := strings.IndexByte(line, ' '); idx > -1 {
-			line = line[:idx]
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("unexpected parse errors: diff (-want +got):\n%s", diff)
+	}
+}
+
+func TestBuildErrorsGitHubFormat(t *testing.T) {
+	outPath := filepath.Join(t.TempDir(), "test.out")
+	if err := runCLI([]string{"--output-format=github", "-o=" + outPath, "internal/testdata/build_errors.proto"}); err != ErrParseFailure {
+		t.Fatalf("runCLI() returned %v; want %v", err, ErrParseFailure)
+	}
+	out, err := os.ReadFile(outPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		"::error file=internal/testdata/build_errors.proto,",
+		",title=api-linter։։parse-error::syntax error",
+	} {
+		if !strings.Contains(string(out), want) {
+			t.Errorf("Got\n%s\nwant it to contain\n%s", out, want)
 		}
-		actualLines[idx] = line
 	}
-	if diff := cmp.Diff(expected, actualLines); diff != "" {
-		t.Fatalf("unexpected errors: diff (-want +got):\n%s", diff)
+}
+
+func TestContinueOnParseErrors(t *testing.T) {
+	tempDir := t.TempDir()
+	for name, content := range map[string]string{
+		"good.proto": "syntax = \"proto3\";\npackage test;\nmessage Book {\n  string Title = 1;\n}\n",
+		"bad.proto":  "syntax = \"proto3\";\npackage test;\nmessage Shelf {\n",
+	} {
+		if err := writeFile(filepath.Join(tempDir, name), content); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, test := range []struct {
+		name      string
+		args      []string
+		wantFiles []string
+	}{
+		{"Stop", nil, []string{"bad.proto"}},
+		{"Continue", []string{"--continue-on-parse-errors"}, []string{"bad.proto", "good.proto"}},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			outPath := filepath.Join(tempDir, test.name+".out")
+			args := append([]string{"-I=" + tempDir, "--output-format=json", "-o=" + outPath, "good.proto", "bad.proto"}, test.args...)
+			if err := runCLI(args); err != ErrParseFailure {
+				t.Fatalf("runCLI() returned %v; want %v", err, ErrParseFailure)
+			}
+			out, err := os.ReadFile(outPath)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var responses []struct {
+				FilePath string `json:"file_path"`
+			}
+			if err := json.Unmarshal(out, &responses); err != nil {
+				t.Fatal(err)
+			}
+			var gotFiles []string
+			for _, r := range responses {
+				gotFiles = append(gotFiles, r.FilePath)
+			}
+			if diff := cmp.Diff(test.wantFiles, gotFiles); diff != "" {
+				t.Errorf("unexpected files: diff (-want +got):\n%s", diff)
+			}
+		})
 	}
 }
 
+// readParseErrors returns the positions of the parse errors in the given JSON
+// output, as "file:line:column".
+func readParseErrors(t *testing.T, outPath string) []string {
+	out, err := os.ReadFile(outPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var responses []struct {
+		Problems []struct {
+			RuleID   string `json:"rule_id"`
+			Location struct {
+				Path  string `json:"path"`
+				Start struct {
+					Line   int `json:"line_number"`
+					Column int `json:"column_number"`
+				} `json:"start_position"`
+			} `json:"location"`
+		} `json:"problems"`
+	}
+	if err := json.Unmarshal(out, &responses); err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, r := range responses {
+		for _, p := range r.Problems {
+			if p.RuleID == string(lint.ParseErrorRuleName) {
+				got = append(got, fmt.Sprintf("%s:%d:%d", p.Location.Path, p.Location.Start.Line, p.Location.Start.Column))
+			}
+		}
+	}
+	return got
+}
+
 func TestExitStatusForLintFailure(t *testing.T) {
 	type testCase struct{ testName, rule, proto string }
 	failCase := testCase{
@@ -228,6 +326,151 @@ func TestExitStatusForLintFailure(t *testing.T) {
 	}
 }
 
+func TestBundledCommonProtos(t *testing.T) {
+	tempDir := t.TempDir()
+	protoPath := filepath.Join(tempDir, "test.proto")
+	if err := writeFile(protoPath, `
+		syntax = "proto3";
+		package test;
+		import "google/api/field_behavior.proto";
+		import "google/longrunning/operations.proto";
+		message Book {
+			string name = 1 [(google.api.field_behavior) = IDENTIFIER];
+		}
+	`); err != nil {
+		t.Fatal(err)
+	}
+	args := []string{"-I=" + tempDir, "-o=" + filepath.Join(tempDir, "test.out"), "test.proto"}
+
+	t.Run("Enabled", func(t *testing.T) {
+		stderr, err := os.Create(filepath.Join(tempDir, "stderr"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer stderr.Close()
+		defer func(orig *os.File) { os.Stderr = orig }(os.Stderr)
+		os.Stderr = stderr
+
+		if err := runCLI(args); err != nil {
+			t.Errorf("runCLI() returned error %v", err)
+		}
+		got, err := os.ReadFile(stderr.Name())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := "bundled common protos (" + commonprotos.Version() + ")"; strings.Count(string(got), want) != 1 {
+			t.Errorf("Got stderr %q; want the version of the bundled common protos once", got)
+		}
+	})
+	t.Run("Disabled", func(t *testing.T) {
+		if err := runCLI(append(args, "--disable-bundled-protos", "--output-format=json")); err != ErrParseFailure {
+			t.Fatalf("runCLI() returned %v; want %v", err, ErrParseFailure)
+		}
+		out, err := os.ReadFile(filepath.Join(tempDir, "test.out"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(string(out), "google/api/field_behavior.proto") {
+			t.Errorf("Got %s; want an error for the missing import", out)
+		}
+	})
+}
+
+func TestLintDescriptorSets(t *testing.T) {
+	p := internal.Parser{
+		Accessor: protoparse.FileContentsFromMap(map[string]string{
+			"a.proto": `
+				syntax = "proto3";
+				package test;
+				service Library {
+					rpc GetBook(Book) returns (Book);
+				}
+				message Book {}
+			`,
+			"b.proto": `
+				syntax = "proto3";
+				package test;
+				message Shelf {}
+			`,
+		}),
+	}
+	fds, err := p.ParseFiles("a.proto", "b.proto")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, test := range []struct {
+		name       string
+		sourceInfo bool
+		args       []string
+		wantFiles  []string
+		wantLoc    string
+	}{
+		{"AllFiles", true, nil, []string{"a.proto", "b.proto"}, `"suggestion":"GetBookRequest","location":{"start_position":{"line_number":5`},
+		{"SelectedFiles", true, []string{"a*.proto"}, []string{"a.proto"}, `"suggestion":"GetBookRequest","location":{"start_position":{"line_number":5`},
+		{"NoSourceInfo", false, []string{"a.proto"}, []string{"a.proto"}, `"element":"test.Library.GetBook"`},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			tempDir := t.TempDir()
+			set := &dpb.FileDescriptorSet{}
+			for _, fd := range fds {
+				fdp := proto.Clone(fd.AsFileDescriptorProto()).(*dpb.FileDescriptorProto)
+				if !test.sourceInfo {
+					fdp.SourceCodeInfo = nil
+				}
+				set.File = append(set.File, fdp)
+			}
+			b, err := proto.Marshal(set)
+			if err != nil {
+				t.Fatal(err)
+			}
+			setPath := filepath.Join(tempDir, "image.pb")
+			if err := os.WriteFile(setPath, b, 0o644); err != nil {
+				t.Fatal(err)
+			}
+			outPath := filepath.Join(tempDir, "test.out")
+			args := append([]string{
+				"--descriptor-set-in=" + setPath,
+				"--lint-descriptor-sets",
+				"--output-format=json",
+				"--debug",
+				"--enable-rule=core::0131::request-message-name",
+				"-o=" + outPath,
+			}, test.args...)
+			if err := runCLI(args); err != nil {
+				t.Fatal(err)
+			}
+			out, err := os.ReadFile(outPath)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []struct {
+				FilePath string `json:"file_path"`
+			}
+			if err := json.Unmarshal(out, &got); err != nil {
+				t.Fatal(err)
+			}
+			var gotFiles []string
+			for _, r := range got {
+				gotFiles = append(gotFiles, r.FilePath)
+			}
+			if diff := cmp.Diff(test.wantFiles, gotFiles); diff != "" {
+				t.Errorf("Linted files mismatch (-want +got):\n%s", diff)
+			}
+			if !strings.Contains(string(out), test.wantLoc) {
+				t.Errorf("Got output %s; want location %s", out, test.wantLoc)
+			}
+		})
+	}
+
+	t.Run("NoMatch", func(t *testing.T) {
+		err := runCLI([]string{"--descriptor-set-in=internal/testdata/dummy.protoset", "--lint-descriptor-sets", "missing.proto"})
+		if err == nil || !strings.Contains(err.Error(), "does not match any file") {
+			t.Errorf("runCLI() returned %v; want a no-match error", err)
+		}
+	})
+}
+
 func runLinter(t *testing.T, protoContent, configContent string) string {
 	_, result := runLinterWithFailureStatus(t, protoContent, configContent, []string{})
 	return result
@@ -42,6 +42,14 @@ func main() {
 }
 
 func runCLI(args []string) error {
+	if len(args) > 0 {
+		switch args[0] {
+		case "resources":
+			return newCli(args[1:]).resources()
+		case "serve":
+			return newServeCli(args[1:]).serve(globalRules, globalConfigs)
+		}
+	}
 	c := newCli(args)
 	return c.lint(globalRules, globalConfigs)
 }
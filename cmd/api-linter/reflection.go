@@ -0,0 +1,119 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/jhump/protoreflect/desc"
+	"github.com/jhump/protoreflect/grpcreflect"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// reflectionTimeout bounds the time spent fetching descriptors from a server.
+const reflectionTimeout = 30 * time.Second
+
+// reflectionServices are the services that implement the reflection protocol
+// itself, which are never linted.
+var reflectionServices = map[string]bool{
+	"grpc.reflection.v1.ServerReflection":      true,
+	"grpc.reflection.v1alpha.ServerReflection": true,
+}
+
+// reflectionCredentials returns the credentials of the connection to the
+// reflection server: TLS if asked or if a CA file is given, verifying the
+// server with the certificates of the CA file or else the system roots, and
+// no security otherwise.
+func reflectionCredentials(useTLS bool, caFile string) (credentials.TransportCredentials, error) {
+	if !useTLS && caFile == "" {
+		return insecure.NewCredentials(), nil
+	}
+	config := &tls.Config{MinVersion: tls.VersionTLS12}
+	if caFile != "" {
+		pem, err := os.ReadFile(caFile)
+		if err != nil {
+			return nil, err
+		}
+		config.RootCAs = x509.NewCertPool()
+		if !config.RootCAs.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificate found in %s", caFile)
+		}
+	}
+	return credentials.NewTLS(config), nil
+}
+
+// loadReflectedFileDescriptors fetches the files declaring the given symbols,
+// or the services if none are given, from the gRPC server at the given
+// address through the server reflection protocol. It returns those files, in
+// the order the symbols are listed, and all fetched files including their
+// dependencies, which are only used to resolve references.
+//
+// Servers usually do not send source info, so problems found in these files
+// name the offending element instead of a position.
+func loadReflectedFileDescriptors(address string, creds credentials.TransportCredentials, symbols []string) ([]*desc.FileDescriptor, map[string]*desc.FileDescriptor, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), reflectionTimeout)
+	defer cancel()
+	conn, err := grpc.NewClient(address, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return nil, nil, err
+	}
+	defer conn.Close()
+	client := grpcreflect.NewClientAuto(ctx, conn)
+	defer client.Reset()
+
+	if len(symbols) == 0 {
+		services, err := client.ListServices()
+		if err != nil {
+			return nil, nil, fmt.Errorf("listing services of %s: %w", address, err)
+		}
+		for _, service := range services {
+			if !reflectionServices[service] {
+				symbols = append(symbols, service)
+			}
+		}
+	}
+	var files []*desc.FileDescriptor
+	targeted := map[string]bool{}
+	all := map[string]*desc.FileDescriptor{}
+	var add func(f *desc.FileDescriptor)
+	add = func(f *desc.FileDescriptor) {
+		if _, found := all[f.GetName()]; found {
+			return
+		}
+		all[f.GetName()] = f
+		for _, dep := range f.GetDependencies() {
+			add(dep)
+		}
+	}
+	for _, symbol := range symbols {
+		f, err := client.FileContainingSymbol(symbol)
+		if err != nil {
+			return nil, nil, fmt.Errorf("resolving %s: %w", symbol, err)
+		}
+		if !targeted[f.GetName()] {
+			targeted[f.GetName()] = true
+			files = append(files, f)
+		}
+		add(f)
+	}
+	return files, all, nil
+}
@@ -0,0 +1,210 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	lrpb "cloud.google.com/go/longrunning/autogen/longrunningpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/reflection"
+)
+
+// startReflectionServer starts a local gRPC server exposing the
+// google.longrunning.Operations service through server reflection, and
+// returns its address.
+func startReflectionServer(t *testing.T, opts ...grpc.ServerOption) string {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := grpc.NewServer(opts...)
+	lrpb.RegisterOperationsServer(s, &lrpb.UnimplementedOperationsServer{})
+	reflection.Register(s)
+	go s.Serve(lis)
+	t.Cleanup(s.Stop)
+	return lis.Addr().String()
+}
+
+func TestLoadReflectedFileDescriptors(t *testing.T) {
+	files, all, err := loadReflectedFileDescriptors(startReflectionServer(t), insecure.NewCredentials(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 1 || files[0].GetName() != "google/longrunning/operations.proto" {
+		t.Errorf("Got files %v; want only google/longrunning/operations.proto", files)
+	}
+	for _, name := range []string{"google/longrunning/operations.proto", "google/api/annotations.proto", "google/protobuf/any.proto"} {
+		if _, found := all[name]; !found {
+			t.Errorf("Dependency %s was not fetched", name)
+		}
+	}
+}
+
+func TestGRPCReflection(t *testing.T) {
+	addr := startReflectionServer(t)
+	for _, test := range []struct {
+		name      string
+		args      []string
+		wantFiles []string
+	}{
+		{"Services", nil, []string{"google/longrunning/operations.proto"}},
+		{"Symbols", []string{"google.api.HttpRule", "google.longrunning.Operations"}, []string{"google/api/http.proto", "google/longrunning/operations.proto"}},
+		{"SameFile", []string{"google.longrunning.Operation", "google.longrunning.Operations"}, []string{"google/longrunning/operations.proto"}},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			outPath := filepath.Join(t.TempDir(), "test.out")
+			args := append([]string{
+				"--grpc-reflection=" + addr,
+				"--output-format=json",
+				"-o=" + outPath,
+			}, test.args...)
+			if err := runCLI(args); err != nil {
+				t.Fatal(err)
+			}
+			out, err := os.ReadFile(outPath)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []struct {
+				FilePath string `json:"file_path"`
+				Problems []struct {
+					Location struct {
+						Element string `json:"element"`
+					} `json:"location"`
+				} `json:"problems"`
+			}
+			if err := json.Unmarshal(out, &got); err != nil {
+				t.Fatal(err)
+			}
+			var gotFiles []string
+			for _, r := range got {
+				gotFiles = append(gotFiles, r.FilePath)
+				// Servers send no source info, so problems name the element.
+				for _, p := range r.Problems {
+					if p.Location.Element == "" {
+						t.Errorf("Problem in %s has no element in %s", r.FilePath, out)
+					}
+				}
+			}
+			if strings.Join(gotFiles, ",") != strings.Join(test.wantFiles, ",") {
+				t.Errorf("Linted files %v; want %v", gotFiles, test.wantFiles)
+			}
+		})
+	}
+}
+
+func TestGRPCReflectionUnknownSymbol(t *testing.T) {
+	err := runCLI([]string{"--grpc-reflection=" + startReflectionServer(t), "google/longrunning/operations.proto"})
+	if err == nil || !strings.Contains(err.Error(), "resolving google/longrunning/operations.proto") {
+		t.Errorf("runCLI() returned %v; want an error resolving the symbol", err)
+	}
+}
+
+// startTLSReflectionServer starts a reflection server like
+// startReflectionServer, serving TLS with a self-signed certificate for
+// 127.0.0.1. It returns its address and the path of the certificate in PEM.
+func startTLSReflectionServer(t *testing.T) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "api-linter test"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IPAddresses:           []net.IP{net.ParseIP("127.0.0.1")},
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	caPath := filepath.Join(t.TempDir(), "ca.pem")
+	if err := os.WriteFile(caPath, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	cert := tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}
+	addr := startReflectionServer(t, grpc.Creds(credentials.NewServerTLSFromCert(&cert)))
+	return addr, caPath
+}
+
+func TestGRPCReflectionTLS(t *testing.T) {
+	addr, caPath := startTLSReflectionServer(t)
+	notPEM := filepath.Join(t.TempDir(), "ca.txt")
+	if err := os.WriteFile(notPEM, []byte("not a certificate"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	for _, test := range []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{"CAFile", []string{"--grpc-reflection-ca-file=" + caPath}, ""},
+		{"CAFileWithTLS", []string{"--grpc-reflection-tls", "--grpc-reflection-ca-file=" + caPath}, ""},
+		{"SystemRoots", []string{"--grpc-reflection-tls"}, "certificate"},
+		{"MissingCAFile", []string{"--grpc-reflection-ca-file=" + filepath.Join(t.TempDir(), "missing.pem")}, "missing.pem"},
+		{"InvalidCAFile", []string{"--grpc-reflection-ca-file=" + notPEM}, "no certificate found"},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			outPath := filepath.Join(t.TempDir(), "test.out")
+			args := append([]string{"--grpc-reflection=" + addr, "--output-format=json", "-o=" + outPath}, test.args...)
+			err := runCLI(args)
+			if test.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+					t.Errorf("runCLI() returned %v; want an error containing %q", err, test.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			out, err := os.ReadFile(outPath)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !strings.Contains(string(out), `"file_path":"google/longrunning/operations.proto"`) {
+				t.Errorf("Got output %s; want the problems of google/longrunning/operations.proto", out)
+			}
+		})
+	}
+}
+
+func TestGRPCReflectionWithDescriptorSets(t *testing.T) {
+	err := runCLI([]string{"--grpc-reflection=localhost:0", "--lint-descriptor-sets"})
+	if err == nil || !strings.Contains(err.Error(), "cannot be used together") {
+		t.Errorf("runCLI() returned %v; want a conflicting flags error", err)
+	}
+}
@@ -0,0 +1,56 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/googleapis/api-linter/cmd/internal/format"
+	"github.com/googleapis/api-linter/rules"
+)
+
+// resources prints the resource graph of the given proto files.
+//
+// JSON is the default format; "dot" renders the graph for Graphviz.
+func (c *cli) resources() error {
+	if len(c.ProtoFiles) == 0 {
+		return fmt.Errorf("no file to graph")
+	}
+	descs, err := loadFileDescriptors(c.ProtoDescPath...)
+	if err != nil {
+		return err
+	}
+	fd, err := c.parseProtos(descs)
+	if err != nil {
+		return err
+	}
+	g := rules.ResourceGraph(fd...)
+
+	var b []byte
+	switch formatType := strings.ToLower(c.FormatType); formatType {
+	case "dot":
+		b = g.DOT()
+	case "":
+		b, err = json.Marshal(g)
+	default:
+		b, err = format.Marshal(formatType, g)
+	}
+	if err != nil {
+		return err
+	}
+	return c.writeOutput(b)
+}
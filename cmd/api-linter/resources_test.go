@@ -0,0 +1,90 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/googleapis/api-linter/internal/resourcegraph"
+)
+
+func TestResources(t *testing.T) {
+	for _, test := range []struct {
+		name   string
+		format string
+		want   string
+	}{
+		{"DefaultJSON", "", `{"resources":[],"edges":[]}`},
+		{"DOT", "dot", "digraph resources {"},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			out := filepath.Join(t.TempDir(), "graph.out")
+			args := []string{"resources", "-o=" + out, "internal/testdata/dummy.proto"}
+			if test.format != "" {
+				args = append(args, "--output-format="+test.format)
+			}
+			if err := runCLI(args); err != nil {
+				t.Fatal(err)
+			}
+			got, err := os.ReadFile(out)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !strings.HasPrefix(string(got), test.want) {
+				t.Errorf("resources output = %q, want prefix %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestResources_Edges(t *testing.T) {
+	out := filepath.Join(t.TempDir(), "graph.json")
+	if err := runCLI([]string{"resources", "-o=" + out, "internal/testdata/library.proto"}); err != nil {
+		t.Fatal(err)
+	}
+	b, err := os.ReadFile(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got resourcegraph.Graph
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatal(err)
+	}
+	want := []*resourcegraph.Edge{
+		{From: "library.googleapis.com/Book", To: "library.googleapis.com/Author", Kind: resourcegraph.Reference, Field: "library.Book.author"},
+		{From: "library.googleapis.com/Publisher", To: "library.googleapis.com/Book", Kind: resourcegraph.Parent},
+	}
+	if diff := cmp.Diff(want, got.Edges); diff != "" {
+		t.Errorf("resources edges mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestResources_NoFiles(t *testing.T) {
+	if err := runCLI([]string{"resources"}); err == nil {
+		t.Error("expected an error when no files are given")
+	}
+}
+
+func TestLint_DOTFormat(t *testing.T) {
+	err := runCLI([]string{"--output-format=dot", "internal/testdata/dummy.proto"})
+	if err == nil || !strings.Contains(err.Error(), "api-linter resources") {
+		t.Errorf("runCLI() got error %v, want the dot format to be rejected", err)
+	}
+}
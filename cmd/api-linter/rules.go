@@ -5,7 +5,9 @@ import (
 	"fmt"
 	"os"
 	"sort"
+	"strings"
 
+	"github.com/googleapis/api-linter/cmd/internal/format"
 	"github.com/googleapis/api-linter/lint"
 	"github.com/olekukonko/tablewriter"
 )
@@ -47,12 +49,14 @@ func outputRules(formatType string) error {
 
 	sort.Sort(listedRulesByName(rules))
 
-	// Determine the format for printing the results.
-	// YAML format is the default.
-	marshal := getOutputFormatFunc(formatType)
-
-	// Print the results.
-	b, err := marshal(rules)
+	// Print the results. YAML format is the default.
+	var b []byte
+	var err error
+	if strings.ToLower(formatType) == "summary" {
+		b, err = rules.printSummaryTable()
+	} else {
+		b, err = format.Marshal(formatType, rules)
+	}
 	if err != nil {
 		return err
 	}
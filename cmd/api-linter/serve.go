@@ -0,0 +1,247 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/googleapis/api-linter/apilinter"
+	"github.com/googleapis/api-linter/lint"
+	"github.com/jhump/protoreflect/desc"
+	dpb "google.golang.org/protobuf/types/descriptorpb"
+)
+
+// serve runs an HTTP server that lints the proto files posted to `/lint` and
+// lists the rules at `/rules`.
+func (c *cli) serve(rules lint.RuleRegistry, configs lint.Configs) error {
+	configs, err := c.configs(configs)
+	if err != nil {
+		return err
+	}
+	if err := lint.CheckRuleOptions(rules, configs); err != nil {
+		return err
+	}
+	serviceConfig, err := c.serviceConfig()
+	if err != nil {
+		return err
+	}
+	// The descriptor sets resolve the imports of every request, and their
+	// files are visible to rules resolving references.
+	var sets []*dpb.FileDescriptorSet
+	var files []*dpb.FileDescriptorProto
+	for _, path := range c.ProtoDescPath {
+		set, err := readFileDescriptorSet(path)
+		if err != nil {
+			return err
+		}
+		sets = append(sets, set)
+		files = append(files, set.GetFile()...)
+	}
+	descs, err := desc.CreateFileDescriptors(files)
+	if err != nil {
+		return err
+	}
+	s := &lintServer{
+		rules:   rules,
+		configs: configs,
+		opts: []lint.LinterOption{
+			lint.Debug(c.DebugFlag),
+			lint.Verbose(c.VerboseFlag),
+			lint.IgnoreCommentDisables(c.IgnoreCommentDisablesFlag),
+			lint.LookupFiles(sortedFileDescriptors(descs)...),
+			lint.ServiceConfig(serviceConfig),
+		},
+		descriptorSets:       sets,
+		disableBundledProtos: c.DisableBundledProtos,
+		timeout:              c.ServeRequestTimeout,
+		maxRequestBytes:      c.ServeMaxRequestBytes,
+	}
+	server := &http.Server{
+		Addr:              c.ServeAddress,
+		Handler:           s.handler(),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	fmt.Fprintf(os.Stderr, "Serving on http://%s\n", c.ServeAddress)
+	return server.ListenAndServe()
+}
+
+// lintServer lints the proto files posted to it. The rules and the linter
+// built from the server's configs are shared by all requests.
+type lintServer struct {
+	rules                lint.RuleRegistry
+	configs              lint.Configs
+	opts                 []lint.LinterOption
+	descriptorSets       []*dpb.FileDescriptorSet
+	disableBundledProtos bool
+	timeout              time.Duration
+	maxRequestBytes      int64
+
+	linter *lint.Linter
+}
+
+// lintRequest is the body of a request to `/lint`.
+type lintRequest struct {
+	// Files maps the names of the files to lint to their contents. Every
+	// file is linted, and files may import each other.
+	Files map[string]string `json:"files"`
+
+	// Config is added to the server's configs for this request. It uses the
+	// format of the config file.
+	Config lint.Configs `json:"config"`
+}
+
+// errorResponse is the body of a response to a failed request.
+type errorResponse struct {
+	Error       string                 `json:"error"`
+	ParseErrors []apilinter.ParseError `json:"parse_errors,omitempty"`
+}
+
+// ruleInfo describes a rule listed by `/rules`.
+type ruleInfo struct {
+	Name   lint.RuleName `json:"name"`
+	AIP    int           `json:"aip"`
+	DocURI string        `json:"doc_uri,omitempty"`
+}
+
+func (s *lintServer) handler() http.Handler {
+	s.linter = lint.New(s.rules, s.configs, s.opts...)
+	mux := http.NewServeMux()
+	mux.HandleFunc("/lint", s.handleLint)
+	mux.HandleFunc("/rules", s.handleRules)
+	return mux
+}
+
+func (s *lintServer) handleLint(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		writeJSON(w, http.StatusMethodNotAllowed, errorResponse{Error: "use POST to lint files"})
+		return
+	}
+	var req lintRequest
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, s.maxRequestBytes)).Decode(&req); err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			writeJSON(w, http.StatusRequestEntityTooLarge, errorResponse{Error: fmt.Sprintf("the request exceeds %d bytes", tooLarge.Limit)})
+			return
+		}
+		writeJSON(w, http.StatusBadRequest, errorResponse{Error: fmt.Sprintf("invalid request: %v", err)})
+		return
+	}
+	if len(req.Files) == 0 {
+		writeJSON(w, http.StatusBadRequest, errorResponse{Error: "no file to lint"})
+		return
+	}
+	if err := lint.CheckRuleOptions(s.rules, req.Config); err != nil {
+		writeJSON(w, http.StatusBadRequest, errorResponse{Error: fmt.Sprintf("invalid config: %v", err)})
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), s.timeout)
+	defer cancel()
+	linter := s.linter
+	if len(req.Config) > 0 {
+		// Request configs come after the server's, so that they take
+		// precedence.
+		linter = lint.New(s.rules, append(append(lint.Configs{}, s.configs...), req.Config...), s.opts...)
+	}
+	files := make([]string, 0, len(req.Files))
+	for name := range req.Files {
+		if !fs.ValidPath(name) {
+			writeJSON(w, http.StatusBadRequest, errorResponse{Error: fmt.Sprintf("invalid file name %q", name)})
+			return
+		}
+		files = append(files, name)
+	}
+	sort.Strings(files)
+	// The linter only checks the context between rules, so run it aside to
+	// answer on time even if a rule is slow.
+	type lintResult struct {
+		result *apilinter.Result
+		err    error
+	}
+	done := make(chan lintResult, 1)
+	go func() {
+		result, err := apilinter.Lint(ctx, apilinter.Request{
+			Sources:              apilinter.MapSources(req.Files),
+			Files:                files,
+			DescriptorSets:       s.descriptorSets,
+			DisableBundledProtos: s.disableBundledProtos,
+			Linter:               linter,
+		})
+		done <- lintResult{result, err}
+	}()
+	var result *apilinter.Result
+	var err error
+	select {
+	case res := <-done:
+		result, err = res.result, res.err
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		writeJSON(w, http.StatusServiceUnavailable, errorResponse{Error: fmt.Sprintf("linting took longer than %v", s.timeout)})
+	case err != nil:
+		writeJSON(w, http.StatusInternalServerError, errorResponse{Error: err.Error()})
+	case len(result.ParseErrors) > 0:
+		writeJSON(w, http.StatusBadRequest, errorResponse{Error: "the files could not be parsed", ParseErrors: result.ParseErrors})
+	default:
+		responses := result.Responses
+		if responses == nil {
+			responses = []lint.Response{}
+		}
+		writeJSON(w, http.StatusOK, responses)
+	}
+}
+
+func (s *lintServer) handleRules(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", http.MethodGet)
+		writeJSON(w, http.StatusMethodNotAllowed, errorResponse{Error: "use GET to list rules"})
+		return
+	}
+	rules := make([]ruleInfo, 0, len(s.rules))
+	for name := range s.rules {
+		info := ruleInfo{Name: name, DocURI: name.URI()}
+		// Rule names have the form "group::aip::name".
+		if parts := strings.Split(string(name), "::"); len(parts) > 1 {
+			info.AIP, _ = strconv.Atoi(parts[1])
+		}
+		rules = append(rules, info)
+	}
+	sort.Slice(rules, func(i, j int) bool { return rules[i].Name < rules[j].Name })
+	writeJSON(w, http.StatusOK, rules)
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	w.Write(b)
+}
@@ -0,0 +1,214 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/googleapis/api-linter/lint"
+	"github.com/jhump/protoreflect/desc"
+)
+
+const serveTestProto = `syntax = "proto3";
+package test;
+message Book {
+  string Title = 1;
+}
+`
+
+func newTestServer(t *testing.T, s *lintServer) *httptest.Server {
+	if s.rules == nil {
+		s.rules = globalRules
+	}
+	if s.timeout == 0 {
+		s.timeout = time.Minute
+	}
+	if s.maxRequestBytes == 0 {
+		s.maxRequestBytes = 1 << 20
+	}
+	server := httptest.NewServer(s.handler())
+	t.Cleanup(server.Close)
+	return server
+}
+
+func postLint(t *testing.T, url string, req lintRequest) (int, []byte) {
+	body, err := json.Marshal(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := http.Post(url+"/lint", "application/json", strings.NewReader(string(body)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return resp.StatusCode, b
+}
+
+func TestServeLint(t *testing.T) {
+	server := newTestServer(t, &lintServer{})
+	for _, test := range []struct {
+		name     string
+		config   lint.Configs
+		wantRule bool
+	}{
+		{"ServerConfig", nil, true},
+		{"RequestConfig", lint.Configs{{DisabledRules: []string{"core::0140::lower-snake"}}}, false},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			status, body := postLint(t, server.URL, lintRequest{
+				Files:  map[string]string{"test/book.proto": serveTestProto},
+				Config: test.config,
+			})
+			if status != http.StatusOK {
+				t.Fatalf("Got status %d (%s); want %d", status, body, http.StatusOK)
+			}
+			var got []struct {
+				FilePath string `json:"file_path"`
+				Problems []struct {
+					RuleID string `json:"rule_id"`
+				} `json:"problems"`
+			}
+			if err := json.Unmarshal(body, &got); err != nil {
+				t.Fatal(err)
+			}
+			if len(got) != 1 || got[0].FilePath != "test/book.proto" {
+				t.Fatalf("Got %s; want one response for test/book.proto", body)
+			}
+			var gotRule bool
+			for _, p := range got[0].Problems {
+				gotRule = gotRule || p.RuleID == "core::0140::lower-snake"
+			}
+			if gotRule != test.wantRule {
+				t.Errorf("Got core::0140::lower-snake problem %t; want %t", gotRule, test.wantRule)
+			}
+		})
+	}
+}
+
+func TestServeLintErrors(t *testing.T) {
+	for _, test := range []struct {
+		name       string
+		server     *lintServer
+		files      map[string]string
+		config     lint.Configs
+		wantStatus int
+		wantError  string
+	}{
+		{"NoFiles", &lintServer{}, nil, nil, http.StatusBadRequest, "no file to lint"},
+		{"InvalidName", &lintServer{}, map[string]string{"../book.proto": serveTestProto}, nil, http.StatusBadRequest, "invalid file name"},
+		{"ParseError", &lintServer{}, map[string]string{"book.proto": "syntax = \"proto3\";\nmessage {"}, nil, http.StatusBadRequest, "could not be parsed"},
+		{"TooLarge", &lintServer{maxRequestBytes: 10}, map[string]string{"book.proto": serveTestProto}, nil, http.StatusRequestEntityTooLarge, "exceeds 10 bytes"},
+		{"Timeout", &lintServer{timeout: time.Nanosecond}, map[string]string{"book.proto": serveTestProto}, nil, http.StatusServiceUnavailable, "took longer than"},
+		{"InvalidRuleOptions", &lintServer{}, map[string]string{"book.proto": serveTestProto}, lint.Configs{{RuleOptions: map[string]lint.RuleOptions{"core::0140": {"names": []string{"a"}}}}}, http.StatusBadRequest, "invalid config"},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			server := newTestServer(t, test.server)
+			status, body := postLint(t, server.URL, lintRequest{Files: test.files, Config: test.config})
+			if status != test.wantStatus {
+				t.Errorf("Got status %d; want %d", status, test.wantStatus)
+			}
+			var got errorResponse
+			if err := json.Unmarshal(body, &got); err != nil {
+				t.Fatal(err)
+			}
+			if !strings.Contains(got.Error, test.wantError) {
+				t.Errorf("Got error %q; want it to contain %q", got.Error, test.wantError)
+			}
+			if test.name == "ParseError" && (len(got.ParseErrors) == 0 || got.ParseErrors[0].Line != 2) {
+				t.Errorf("Got parse errors %v; want one at line 2", got.ParseErrors)
+			}
+		})
+	}
+}
+
+func TestServeLintTimeoutInRule(t *testing.T) {
+	// The rule blocks until the test ends, so only the timeout can end the
+	// request.
+	unblock := make(chan struct{})
+	defer close(unblock)
+	rules := lint.NewRuleRegistry()
+	err := rules.Register(111, &lint.FileRule{
+		Name: lint.NewRuleName(111, "blocking"),
+		LintFile: func(f *desc.FileDescriptor) []lint.Problem {
+			<-unblock
+			return nil
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := newTestServer(t, &lintServer{rules: rules, timeout: 100 * time.Millisecond})
+	status, body := postLint(t, server.URL, lintRequest{Files: map[string]string{"book.proto": serveTestProto}})
+	if status != http.StatusServiceUnavailable {
+		t.Errorf("Got status %d (%s); want %d", status, body, http.StatusServiceUnavailable)
+	}
+}
+
+func TestServeMethodNotAllowed(t *testing.T) {
+	server := newTestServer(t, &lintServer{})
+	for _, test := range []struct {
+		method, path string
+	}{
+		{http.MethodGet, "/lint"},
+		{http.MethodPost, "/rules"},
+	} {
+		req, err := http.NewRequest(test.method, server.URL+test.path, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusMethodNotAllowed {
+			t.Errorf("%s %s: got status %d; want %d", test.method, test.path, resp.StatusCode, http.StatusMethodNotAllowed)
+		}
+	}
+}
+
+func TestServeRules(t *testing.T) {
+	server := newTestServer(t, &lintServer{})
+	resp, err := http.Get(server.URL + "/rules")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	var got []ruleInfo
+	if err := json.NewDecoder(resp.Body).Decode(&got); err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != len(globalRules) {
+		t.Errorf("Got %d rules; want %d", len(got), len(globalRules))
+	}
+	want := ruleInfo{Name: "core::0140::lower-snake", AIP: 140, DocURI: "https://linter.aip.dev/140/lower-snake"}
+	var found bool
+	for _, r := range got {
+		found = found || r == want
+	}
+	if !found {
+		t.Errorf("Got rules without %+v", want)
+	}
+}
@@ -0,0 +1,50 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package format renders lint results in the output formats shared by the
+// `api-linter` command and the `protoc-gen-api-linter` plugin.
+package format
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/googleapis/api-linter/lint"
+	"gopkg.in/yaml.v3"
+)
+
+// Responses renders the given lint responses in the given format: "yaml"
+// (the default), "yml", "json", "github" or "summary".
+func Responses(format string, responses []lint.Response) ([]byte, error) {
+	switch strings.ToLower(format) {
+	case "github":
+		return GitHubActions(responses), nil
+	case "summary":
+		return SummaryTable(responses)
+	default:
+		return Marshal(format, responses)
+	}
+}
+
+// Marshal renders a value other than lint responses, such as a list of rules,
+// in the given format. The formats made for lint responses, "github" and
+// "summary", fall back to JSON.
+func Marshal(format string, v interface{}) ([]byte, error) {
+	switch strings.ToLower(format) {
+	case "json", "github", "summary":
+		return json.Marshal(v)
+	default:
+		return yaml.Marshal(v)
+	}
+}
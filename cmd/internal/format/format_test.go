@@ -0,0 +1,46 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package format
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestMarshal(t *testing.T) {
+	value := map[string]string{"name": "books"}
+	for _, test := range []struct {
+		name   string
+		format string
+		want   string
+	}{
+		{"Default", "", "name: books\n"},
+		{"YAML", "yml", "name: books\n"},
+		{"JSON", "JSON", `{"name":"books"}`},
+		{"GitHub", "github", `{"name":"books"}`},
+		{"Summary", "summary", `{"name":"books"}`},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := Marshal(test.format, value)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(test.want, string(got)); diff != "" {
+				t.Errorf("Marshal() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
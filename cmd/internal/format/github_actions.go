@@ -0,0 +1,103 @@
+// Copyright 2022 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package format
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/googleapis/api-linter/lint"
+	dpb "google.golang.org/protobuf/types/descriptorpb"
+)
+
+// GitHubActions returns lint errors in GitHub actions format.
+func GitHubActions(responses []lint.Response) []byte {
+	var buf bytes.Buffer
+	for _, response := range responses {
+		for _, problem := range response.Problems {
+			// lint example:
+			// ::error file={name},line={line},endLine={endLine},title={title}::{message}
+			// https://docs.github.com/en/actions/using-workflows/workflow-commands-for-github-actions#setting-an-error-message
+
+			// Informational problems are not errors.
+			command := "error"
+			if problem.Informational {
+				command = "notice"
+			}
+			fmt.Fprintf(&buf, "::%s file=%s", command, response.FilePath)
+			writeGitHubLocation(&buf, problem.Location)
+
+			// GitHub uses :: as control characters (which are also used to delimit
+			// linter rules. In order to prevent confusion, replace the double colon
+			// with two Armenian full stops which are indistinguishable to my eye.
+			runeThatLooksLikeTwoColonsButIsActuallyTwoArmenianFullStops := "։։"
+			title := strings.ReplaceAll(string(problem.RuleID), "::", runeThatLooksLikeTwoColonsButIsActuallyTwoArmenianFullStops)
+			message := strings.ReplaceAll(problem.Message, "\n", "\\n")
+			// Without any source info (e.g. when linting a descriptor set built
+			// without it), name the offending element instead.
+			if problem.Location == nil && problem.Descriptor != nil && problem.Descriptor.GetSourceInfo() == nil {
+				message += fmt.Sprintf(" (%s)", problem.Descriptor.GetFullyQualifiedName())
+			}
+			uri := problem.GetRuleURI()
+			if uri != "" {
+				message += "\\n\\n" + uri
+			}
+			fmt.Fprintf(&buf, ",title=%s::%s\n", title, message)
+
+			// Emit each related location as a notice, so that reviewers can
+			// jump to the other side of the problem.
+			for _, related := range problem.RelatedLocations {
+				path := response.FilePath
+				if related.Descriptor != nil {
+					path = related.Descriptor.GetFile().GetName()
+				}
+				loc := related.Location
+				if loc == nil && related.Descriptor != nil {
+					loc = related.Descriptor.GetSourceInfo()
+				}
+				fmt.Fprintf(&buf, "::notice file=%s", path)
+				writeGitHubLocation(&buf, loc)
+				fmt.Fprintf(&buf, ",title=%s::%s\n", title, strings.ReplaceAll(related.Message, "\n", "\\n"))
+			}
+		}
+	}
+
+	return buf.Bytes()
+}
+
+// writeGitHubLocation writes the location parameters of a GitHub workflow
+// command.
+func writeGitHubLocation(buf *bytes.Buffer, loc *dpb.SourceCodeInfo_Location) {
+	if loc == nil {
+		return
+	}
+	// Some findings are *line level* and only have start positions but no
+	// starting column. Construct a switch fallthrough to emit as many of
+	// the location indicators are included.
+	switch len(loc.Span) {
+	case 4:
+		fmt.Fprintf(buf, ",endColumn=%d", loc.Span[3])
+		fallthrough
+	case 3:
+		fmt.Fprintf(buf, ",endLine=%d", loc.Span[2])
+		fallthrough
+	case 2:
+		fmt.Fprintf(buf, ",col=%d", loc.Span[1])
+		fallthrough
+	case 1:
+		fmt.Fprintf(buf, ",line=%d", loc.Span[0])
+	}
+}
@@ -12,16 +12,32 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-package main
+package format
 
 import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/googleapis/api-linter/lint"
+	"github.com/jhump/protoreflect/desc"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/descriptorpb"
 )
 
+// noSourceInfo returns a message descriptor without source info, as found in
+// descriptor sets built without it.
+func noSourceInfo(t *testing.T) *desc.MessageDescriptor {
+	f, err := desc.CreateFileDescriptor(&descriptorpb.FileDescriptorProto{
+		Name:        proto.String("example.proto"),
+		Package:     proto.String("test"),
+		MessageType: []*descriptorpb.DescriptorProto{{Name: proto.String("Book")}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return f.GetMessageTypes()[0]
+}
+
 func TestFormatGitHubActionOutput(t *testing.T) {
 	tests := []struct {
 		name string
@@ -100,6 +116,69 @@ func TestFormatGitHubActionOutput(t *testing.T) {
 			},
 			want: `::error file=example.proto,endColumn=4,endLine=3,col=2,line=1,title=core։։naming_formats։։field_names::\n\nhttps://linter.aip.dev/naming_formats/field_names
 ::error file=example.proto,endColumn=8,endLine=7,col=6,line=5,title=core։։naming_formats։։field_names::multi\nline\ncomment\n\nhttps://linter.aip.dev/naming_formats/field_names
+`,
+		},
+		{
+			name: "Example with related locations",
+			data: []lint.Response{
+				{
+					FilePath: "example.proto",
+					Problems: []lint.Problem{
+						{
+							RuleID:  "core::0123::duplicate-resource",
+							Message: "Multiple definitions",
+							Location: &descriptorpb.SourceCodeInfo_Location{
+								Span: []int32{1, 2, 3, 4},
+							},
+							RelatedLocations: []lint.RelatedLocation{{
+								Message: "other\ndefinition",
+								Location: &descriptorpb.SourceCodeInfo_Location{
+									Span: []int32{5, 6, 7, 8},
+								},
+							}},
+						},
+					},
+				},
+			},
+			want: `::error file=example.proto,endColumn=4,endLine=3,col=2,line=1,title=core։։0123։։duplicate-resource::Multiple definitions\n\nhttps://linter.aip.dev/123/duplicate-resource
+::notice file=example.proto,endColumn=8,endLine=7,col=6,line=5,title=core։։0123։։duplicate-resource::other\ndefinition
+`,
+		},
+		{
+			name: "Example with an informational problem",
+			data: []lint.Response{
+				{
+					FilePath: "example.proto",
+					Problems: []lint.Problem{
+						{
+							RuleID:        "client-libraries::4222::implicit-routing",
+							Message:       "Implicit routing.",
+							Informational: true,
+							Location: &descriptorpb.SourceCodeInfo_Location{
+								Span: []int32{1, 2, 3, 4},
+							},
+						},
+					},
+				},
+			},
+			want: `::notice file=example.proto,endColumn=4,endLine=3,col=2,line=1,title=client-libraries։։4222։։implicit-routing::Implicit routing.\n\nhttps://linter.aip.dev/4222/implicit-routing
+`,
+		},
+		{
+			name: "Example without source info",
+			data: []lint.Response{
+				{
+					FilePath: "example.proto",
+					Problems: []lint.Problem{
+						{
+							RuleID:     "core::0192::has-comments",
+							Message:    "Missing comment.",
+							Descriptor: noSourceInfo(t),
+						},
+					},
+				},
+			},
+			want: `::error file=example.proto,title=core։։0192։։has-comments::Missing comment. (test.Book)\n\nhttps://linter.aip.dev/192/has-comments
 `,
 		},
 		{
@@ -145,9 +224,9 @@ func TestFormatGitHubActionOutput(t *testing.T) {
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
-			got := formatGitHubActionOutput(test.data)
+			got := GitHubActions(test.data)
 			if diff := cmp.Diff(string(test.want), string(got)); diff != "" {
-				t.Errorf("formatGitHubActionOutput() mismatch (-want +got):\n%s", diff)
+				t.Errorf("GitHubActions() mismatch (-want +got):\n%s", diff)
 			}
 		})
 	}
@@ -12,7 +12,7 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-package main
+package format
 
 import (
 	"bytes"
@@ -23,8 +23,9 @@ import (
 	"github.com/olekukonko/tablewriter"
 )
 
-// printSummaryTable returns a summary table of violation counts.
-func printSummaryTable(responses []lint.Response) ([]byte, error) {
+// SummaryTable returns a summary table of violation counts. Informational
+// problems are not violations, and are left out.
+func SummaryTable(responses []lint.Response) ([]byte, error) {
 	s := createSummary(responses)
 
 	data := []summary{}
@@ -58,6 +59,9 @@ func createSummary(responses []lint.Response) map[string]map[string]int {
 	for _, r := range responses {
 		filePath := string(r.FilePath)
 		for _, p := range r.Problems {
+			if p.Informational {
+				continue
+			}
 			ruleID := string(p.RuleID)
 			if summary[ruleID] == nil {
 				summary[ruleID] = make(map[string]int)
@@ -12,7 +12,7 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-package main
+package format
 
 import (
 	"testing"
@@ -58,6 +58,7 @@ func TestCreateSummary(t *testing.T) {
 				Problems: []lint.Problem{
 					{RuleID: "core::naming_formats::field_names"},
 					{RuleID: "core::0132::response_message::name"},
+					{RuleID: "client-libraries::4222::implicit-routing", Informational: true},
 				},
 			},
 		},
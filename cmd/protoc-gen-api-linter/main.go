@@ -0,0 +1,268 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// The protoc plugin `protoc-gen-api-linter` checks Google APIs defined in
+// Protobuf files as part of a `protoc` invocation, using the descriptors and
+// source info that `protoc` provides rather than parsing the files again.
+//
+// Usage:
+//
+//	protoc --api-linter_out=. --api-linter_opt=output_format=json,set_exit_status foo.proto
+//
+// The report is written as a generated file. See parseParameter for the
+// supported options.
+package main
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/googleapis/api-linter/cmd/internal/format"
+	"github.com/googleapis/api-linter/lint"
+	"github.com/googleapis/api-linter/rules"
+	"github.com/jhump/protoreflect/desc"
+	"google.golang.org/genproto/googleapis/api/serviceconfig"
+	"google.golang.org/protobuf/proto"
+	dpb "google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+func main() {
+	if err := run(os.Stdin, os.Stdout); err != nil {
+		log.Fatalln(err)
+	}
+}
+
+// run reads a CodeGeneratorRequest from r and writes the CodeGeneratorResponse
+// to w. Lint failures are reported in the response; only I/O and encoding
+// errors are returned.
+func run(r io.Reader, w io.Writer) error {
+	in, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	req := &pluginpb.CodeGeneratorRequest{}
+	if err := proto.Unmarshal(in, req); err != nil {
+		return err
+	}
+	out, err := proto.Marshal(generate(req))
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(out)
+	return err
+}
+
+// params are the options accepted through the plugin parameter string.
+type params struct {
+	ConfigPath              string
+	ServiceConfigPath       string
+	FormatType              string
+	OutputPath              string
+	ExitStatusOnLintFailure bool
+	EnabledRules            []string
+	DisabledRules           []string
+	IgnoreCommentDisables   bool
+	FailOnInternalErrors    bool
+	Debug                   bool
+	Verbose                 bool
+}
+
+// parseParameter parses the comma-separated plugin parameter string. The
+// supported options are:
+//
+//   - config=<path>: the linter config file.
+//   - service_config=<path>: the service config file of the APIs.
+//   - output_format=<format>: "yaml" (the default), "json", "github" or
+//     "summary".
+//   - output_path=<path>: the name of the generated report, relative to the
+//     output directory. Defaults to "api-linter.<format>".
+//   - set_exit_status: fail the protoc invocation if problems are found.
+//   - enable_rule=<name> and disable_rule=<name>: may be given multiple times.
+//   - ignore_comment_disables: ignore disable comments in the proto files.
+//   - fail_on_internal_errors: fail the protoc invocation if a rule fails.
+//   - debug: run in debug mode.
+//   - verbose: run in verbose mode, also reporting informational problems.
+func parseParameter(parameter string) (params, error) {
+	p := params{FormatType: "yaml"}
+	for _, opt := range strings.Split(parameter, ",") {
+		if opt = strings.TrimSpace(opt); opt == "" {
+			continue
+		}
+		key, value, hasValue := strings.Cut(opt, "=")
+		switch key {
+		case "config":
+			p.ConfigPath = value
+		case "service_config":
+			p.ServiceConfigPath = value
+		case "output_format":
+			p.FormatType = value
+		case "output_path":
+			p.OutputPath = value
+		case "enable_rule":
+			p.EnabledRules = append(p.EnabledRules, value)
+		case "disable_rule":
+			p.DisabledRules = append(p.DisabledRules, value)
+		case "set_exit_status":
+			p.ExitStatusOnLintFailure = !hasValue || value == "true"
+		case "ignore_comment_disables":
+			p.IgnoreCommentDisables = !hasValue || value == "true"
+		case "fail_on_internal_errors":
+			p.FailOnInternalErrors = !hasValue || value == "true"
+		case "debug":
+			p.Debug = !hasValue || value == "true"
+		case "verbose":
+			p.Verbose = !hasValue || value == "true"
+		default:
+			return p, fmt.Errorf("unknown option %q", key)
+		}
+	}
+	if p.OutputPath == "" {
+		p.OutputPath = "api-linter." + strings.ToLower(p.FormatType)
+	}
+	return p, nil
+}
+
+// generate lints the files to generate and returns the report as a generated
+// file.
+func generate(req *pluginpb.CodeGeneratorRequest) *pluginpb.CodeGeneratorResponse {
+	// Advertise editions support, or protoc refuses to send edition files.
+	resp := &pluginpb.CodeGeneratorResponse{
+		SupportedFeatures: proto.Uint64(uint64(pluginpb.CodeGeneratorResponse_FEATURE_PROTO3_OPTIONAL |
+			pluginpb.CodeGeneratorResponse_FEATURE_SUPPORTS_EDITIONS)),
+		MinimumEdition: proto.Int32(int32(dpb.Edition_EDITION_PROTO2)),
+		MaximumEdition: proto.Int32(int32(dpb.Edition_EDITION_2023)),
+	}
+	fail := func(err error) *pluginpb.CodeGeneratorResponse {
+		resp.Error = proto.String(err.Error())
+		return resp
+	}
+
+	p, err := parseParameter(req.GetParameter())
+	if err != nil {
+		return fail(err)
+	}
+
+	// Read linter config and append the enabled and disabled rules.
+	configs := lint.Configs{}
+	if p.ConfigPath != "" {
+		config, err := lint.ReadConfigsFromFile(p.ConfigPath)
+		if err != nil {
+			return fail(err)
+		}
+		configs = append(configs, config...)
+	}
+	configs = append(configs,
+		lint.Config{EnabledRules: p.EnabledRules},
+		lint.Config{DisabledRules: p.DisabledRules},
+	)
+
+	var serviceConfig *serviceconfig.Service
+	if p.ServiceConfigPath != "" {
+		if serviceConfig, err = lint.ReadServiceConfigFromFile(p.ServiceConfigPath); err != nil {
+			return fail(err)
+		}
+	}
+
+	// Build the file descriptors. protoc sends every file in the import
+	// graph, in topological order, but only lints the files to generate.
+	fds, err := desc.CreateFileDescriptors(req.GetProtoFile())
+	if err != nil {
+		return fail(err)
+	}
+	var files []*desc.FileDescriptor
+	for _, name := range req.GetFileToGenerate() {
+		files = append(files, fds[name])
+	}
+	var others []*desc.FileDescriptor
+	for name, fd := range fds {
+		if !contains(req.GetFileToGenerate(), name) {
+			others = append(others, fd)
+		}
+	}
+	sort.Slice(others, func(i, j int) bool {
+		return others[i].GetName() < others[j].GetName()
+	})
+
+	registry := lint.NewRuleRegistry()
+	if err := rules.Add(registry); err != nil {
+		return fail(err)
+	}
+	l := lint.New(registry, configs,
+		lint.Debug(p.Debug),
+		lint.Verbose(p.Verbose),
+		lint.IgnoreCommentDisables(p.IgnoreCommentDisables),
+		lint.LookupFiles(others...),
+		lint.ServiceConfig(serviceConfig),
+	)
+	results, err := l.LintProtos(files...)
+	if err != nil {
+		return fail(err)
+	}
+
+	report, err := format.Responses(p.FormatType, results)
+	if err != nil {
+		return fail(err)
+	}
+	resp.File = []*pluginpb.CodeGeneratorResponse_File{{
+		Name:    proto.String(p.OutputPath),
+		Content: proto.String(string(report)),
+	}}
+
+	// protoc discards the generated files when an error is set, so include
+	// the report in the error itself.
+	if p.FailOnInternalErrors && anyProblems(results, isInternalError) {
+		return fail(fmt.Errorf("rules failed during linting:\n%s", report))
+	}
+	if p.ExitStatusOnLintFailure && anyProblems(results, isLintProblem) {
+		return fail(fmt.Errorf("found problems during linting:\n%s", report))
+	}
+	return resp
+}
+
+// anyProblems returns true if any of the problems matches.
+func anyProblems(results []lint.Response, match func(lint.Problem) bool) bool {
+	for i := range results {
+		for _, p := range results[i].Problems {
+			if match(p) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// isInternalError returns true if the problem reports a rule failure.
+func isInternalError(p lint.Problem) bool {
+	return p.RuleID == lint.InternalErrorRuleName
+}
+
+// isLintProblem returns true if the problem is a violation found by a rule.
+// Informational problems are not violations.
+func isLintProblem(p lint.Problem) bool {
+	return !isInternalError(p) && !p.Informational
+}
+
+func contains(names []string, name string) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
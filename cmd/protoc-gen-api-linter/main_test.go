@@ -0,0 +1,255 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/googleapis/api-linter/internal"
+	"github.com/jhump/protoreflect/desc"
+	"github.com/jhump/protoreflect/desc/protoparse"
+	"google.golang.org/protobuf/proto"
+	dpb "google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+const testProto = `syntax = "proto3";
+
+package test;
+
+import "google/api/annotations.proto";
+
+service Library {
+  rpc GetBook(GetBookRequest) returns (Book) {
+    option (google.api.http) = {
+      get: "/v1/{name=books/*}"
+    };
+  }
+}
+
+message GetBookRequest {
+  string name = 1;
+}
+
+message Book {
+  string name = 1;
+}
+`
+
+const testEditionsProto = `edition = "2023";
+
+package test;
+
+import "google/api/annotations.proto";
+
+service Library {
+  rpc GetBook(GetBookRequest) returns (Book) {
+    option (google.api.http) = {
+      get: "/v1/{name=books/*}"
+    };
+  }
+}
+
+message GetBookRequest {
+  string name = 1;
+}
+
+message Book {
+  string name = 1;
+}
+`
+
+// request builds the CodeGeneratorRequest that protoc would send for
+// test.proto.
+func request(t *testing.T, parameter string) *pluginpb.CodeGeneratorRequest {
+	return requestFor(t, testProto, parameter)
+}
+
+// requestFor builds the CodeGeneratorRequest that protoc would send for
+// test.proto with the given contents.
+func requestFor(t *testing.T, contents, parameter string) *pluginpb.CodeGeneratorRequest {
+	p := internal.Parser{
+		Accessor:     protoparse.FileContentsFromMap(map[string]string{"test.proto": contents}),
+		LookupImport: desc.LoadFileDescriptor,
+	}
+	fds, err := p.ParseFiles("test.proto")
+	if err != nil {
+		t.Fatal(err)
+	}
+	req := &pluginpb.CodeGeneratorRequest{
+		FileToGenerate: []string{"test.proto"},
+		Parameter:      proto.String(parameter),
+	}
+	seen := map[string]bool{}
+	var add func(fd *desc.FileDescriptor)
+	add = func(fd *desc.FileDescriptor) {
+		if seen[fd.GetName()] {
+			return
+		}
+		seen[fd.GetName()] = true
+		for _, dep := range fd.GetDependencies() {
+			add(dep)
+		}
+		fdp := proto.Clone(fd.AsFileDescriptorProto()).(*dpb.FileDescriptorProto)
+		if fd.GetName() != "test.proto" {
+			fdp.SourceCodeInfo = nil
+		}
+		req.ProtoFile = append(req.ProtoFile, fdp)
+	}
+	add(fds[0])
+	return req
+}
+
+func TestGenerate(t *testing.T) {
+	resp := generate(request(t, "output_format=json"))
+	if resp.Error != nil {
+		t.Fatalf("generate() returned error %q", resp.GetError())
+	}
+	if got := len(resp.GetFile()); got != 1 {
+		t.Fatalf("generate() returned %d files; want 1", got)
+	}
+	f := resp.GetFile()[0]
+	if got, want := f.GetName(), "api-linter.json"; got != want {
+		t.Errorf("Report name is %q; want %q", got, want)
+	}
+	var got []struct {
+		FilePath string `json:"file_path"`
+		Problems []struct {
+			RuleID   string `json:"rule_id"`
+			Location struct {
+				Start struct {
+					Line int `json:"line_number"`
+				} `json:"start_position"`
+			} `json:"location"`
+		} `json:"problems"`
+	}
+	if err := json.Unmarshal([]byte(f.GetContent()), &got); err != nil {
+		t.Fatalf("Report is not valid JSON: %v", err)
+	}
+	if len(got) != 1 || got[0].FilePath != "test.proto" {
+		t.Fatalf("Report should cover only test.proto, got %+v", got)
+	}
+	var found bool
+	for _, p := range got[0].Problems {
+		if p.RuleID != "core::0131::request-name-reference" {
+			continue
+		}
+		found = true
+		// The location comes from the source info sent by protoc.
+		if p.Location.Start.Line != 16 {
+			t.Errorf("Got problem on line %d; want line 16", p.Location.Start.Line)
+		}
+	}
+	if !found {
+		t.Errorf("Got problems %+v; want a core::0131::request-name-reference problem", got[0].Problems)
+	}
+}
+
+func TestGenerate_SupportedFeatures(t *testing.T) {
+	resp := generate(request(t, ""))
+	want := uint64(pluginpb.CodeGeneratorResponse_FEATURE_PROTO3_OPTIONAL | pluginpb.CodeGeneratorResponse_FEATURE_SUPPORTS_EDITIONS)
+	if got := resp.GetSupportedFeatures(); got != want {
+		t.Errorf("SupportedFeatures is %d; want %d", got, want)
+	}
+	if got, want := dpb.Edition(resp.GetMinimumEdition()), dpb.Edition_EDITION_PROTO2; got != want {
+		t.Errorf("MinimumEdition is %v; want %v", got, want)
+	}
+	if got, want := dpb.Edition(resp.GetMaximumEdition()), dpb.Edition_EDITION_2023; got != want {
+		t.Errorf("MaximumEdition is %v; want %v", got, want)
+	}
+}
+
+func TestGenerate_Editions(t *testing.T) {
+	resp := generate(requestFor(t, testEditionsProto, "output_format=json"))
+	if resp.Error != nil {
+		t.Fatalf("generate() returned error %q", resp.GetError())
+	}
+	var got []struct {
+		FilePath string `json:"file_path"`
+		Problems []struct {
+			RuleID string `json:"rule_id"`
+		} `json:"problems"`
+	}
+	if err := json.Unmarshal([]byte(resp.GetFile()[0].GetContent()), &got); err != nil {
+		t.Fatalf("Report is not valid JSON: %v", err)
+	}
+	if len(got) != 1 || got[0].FilePath != "test.proto" {
+		t.Fatalf("Report should cover only test.proto, got %+v", got)
+	}
+	var found bool
+	for _, p := range got[0].Problems {
+		found = found || p.RuleID == "core::0131::request-name-reference"
+	}
+	if !found {
+		t.Errorf("Got problems %+v; want a core::0131::request-name-reference problem", got[0].Problems)
+	}
+}
+
+func TestGenerate_SetExitStatus(t *testing.T) {
+	resp := generate(request(t, "set_exit_status,output_format=summary"))
+	if !strings.Contains(resp.GetError(), "found problems during linting") {
+		t.Errorf("generate() error is %q; want lint failure", resp.GetError())
+	}
+}
+
+func TestGenerate_SetExitStatusInformational(t *testing.T) {
+	// Only the informational client-libraries::4222::implicit-routing rule
+	// reports a problem.
+	config := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(config, []byte("- disabled_rules: [all]\n  enabled_rules: [client-libraries::4222::implicit-routing]\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	resp := generate(request(t, "set_exit_status,verbose,config="+config))
+	if resp.Error != nil {
+		t.Fatalf("generate() returned error %q", resp.GetError())
+	}
+	if !strings.Contains(resp.GetFile()[0].GetContent(), "implicit-routing") {
+		t.Errorf("Got report %q; want an implicit-routing problem", resp.GetFile()[0].GetContent())
+	}
+}
+
+func TestGenerate_NoProblems(t *testing.T) {
+	resp := generate(request(t, "set_exit_status,disable_rule=all,output_path=lint/report.yaml"))
+	if resp.Error != nil {
+		t.Fatalf("generate() returned error %q", resp.GetError())
+	}
+	if got, want := resp.GetFile()[0].GetName(), "lint/report.yaml"; got != want {
+		t.Errorf("Report name is %q; want %q", got, want)
+	}
+}
+
+func TestRun(t *testing.T) {
+	in, err := proto.Marshal(request(t, "bogus"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var out bytes.Buffer
+	if err := run(bytes.NewReader(in), &out); err != nil {
+		t.Fatal(err)
+	}
+	resp := &pluginpb.CodeGeneratorResponse{}
+	if err := proto.Unmarshal(out.Bytes(), resp); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(resp.GetError(), `unknown option "bogus"`); diff != "" {
+		t.Errorf(diff)
+	}
+}
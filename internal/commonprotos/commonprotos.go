@@ -0,0 +1,118 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package commonprotos provides the googleapis common protos (such as
+// `google/api/annotations.proto` or `google/longrunning/operations.proto`)
+// that are linked into the linter, so that they can be imported without
+// being vendored.
+package commonprotos
+
+import (
+	"fmt"
+	"runtime/debug"
+	"strings"
+
+	"github.com/jhump/protoreflect/desc"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	// These imports cause the common protos to be registered with the
+	// protocol buffer registry.
+	_ "cloud.google.com/go/longrunning/autogen/longrunningpb"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	_ "google.golang.org/genproto/googleapis/api/httpbody"
+	_ "google.golang.org/genproto/googleapis/rpc/code"
+	_ "google.golang.org/genproto/googleapis/rpc/errdetails"
+	_ "google.golang.org/genproto/googleapis/rpc/status"
+	_ "google.golang.org/genproto/googleapis/type/color"
+	_ "google.golang.org/genproto/googleapis/type/date"
+	_ "google.golang.org/genproto/googleapis/type/datetime"
+	_ "google.golang.org/genproto/googleapis/type/dayofweek"
+	_ "google.golang.org/genproto/googleapis/type/decimal"
+	_ "google.golang.org/genproto/googleapis/type/expr"
+	_ "google.golang.org/genproto/googleapis/type/fraction"
+	_ "google.golang.org/genproto/googleapis/type/interval"
+	_ "google.golang.org/genproto/googleapis/type/latlng"
+	_ "google.golang.org/genproto/googleapis/type/localized_text"
+	_ "google.golang.org/genproto/googleapis/type/money"
+	_ "google.golang.org/genproto/googleapis/type/month"
+	_ "google.golang.org/genproto/googleapis/type/phone_number"
+	_ "google.golang.org/genproto/googleapis/type/postaladdress"
+	_ "google.golang.org/genproto/googleapis/type/quaternion"
+	_ "google.golang.org/genproto/googleapis/type/timeofday"
+)
+
+// prefixes are the import path prefixes of the bundled files.
+var prefixes = []string{
+	"google/api/",
+	"google/longrunning/",
+	"google/rpc/",
+	"google/type/",
+}
+
+// modules are the Go modules that provide the bundled files.
+var modules = []string{
+	"cloud.google.com/go/longrunning",
+	"google.golang.org/genproto",
+	"google.golang.org/genproto/googleapis/api",
+	"google.golang.org/genproto/googleapis/rpc",
+}
+
+// Lookup returns the bundled file with the given import path.
+func Lookup(path string) (*desc.FileDescriptor, error) {
+	if !isCommon(path) {
+		return nil, fmt.Errorf("%q is not a bundled common proto", path)
+	}
+	fd, err := protoregistry.GlobalFiles.FindFileByPath(path)
+	if err != nil {
+		return nil, fmt.Errorf("%q is not a bundled common proto", path)
+	}
+	return desc.WrapFile(fd)
+}
+
+// Version describes the versions of the Go modules that provide the bundled
+// files, e.g. "cloud.google.com/go/longrunning@v0.5.7, ...". It returns
+// "unknown" if the binary was built without module information.
+func Version() string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return "unknown"
+	}
+	versions := map[string]string{}
+	for _, dep := range info.Deps {
+		version := dep.Version
+		if dep.Replace != nil {
+			version = dep.Replace.Version
+		}
+		versions[dep.Path] = version
+	}
+	var answer []string
+	for _, m := range modules {
+		if v, found := versions[m]; found {
+			answer = append(answer, m+"@"+v)
+		}
+	}
+	if len(answer) == 0 {
+		return "unknown"
+	}
+	return strings.Join(answer, ", ")
+}
+
+func isCommon(path string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(path, prefix) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,61 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commonprotos
+
+import (
+	"testing"
+)
+
+func TestLookup(t *testing.T) {
+	for _, path := range []string{
+		"google/api/annotations.proto",
+		"google/api/client.proto",
+		"google/api/field_behavior.proto",
+		"google/api/resource.proto",
+		"google/longrunning/operations.proto",
+		"google/rpc/status.proto",
+		"google/type/money.proto",
+	} {
+		t.Run(path, func(t *testing.T) {
+			fd, err := Lookup(path)
+			if err != nil {
+				t.Fatalf("Lookup(%q) returned error %v", path, err)
+			}
+			if got := fd.GetName(); got != path {
+				t.Errorf("Lookup(%q) returned %q", path, got)
+			}
+		})
+	}
+}
+
+func TestLookupNotFound(t *testing.T) {
+	for _, path := range []string{
+		"google/api/missing.proto",
+		"google/protobuf/descriptor.proto",
+		"foo/bar.proto",
+	} {
+		t.Run(path, func(t *testing.T) {
+			if _, err := Lookup(path); err == nil {
+				t.Errorf("Lookup(%q) should have returned an error", path)
+			}
+		})
+	}
+}
+
+func TestVersion(t *testing.T) {
+	if Version() == "" {
+		t.Errorf("Version() should never be empty")
+	}
+}
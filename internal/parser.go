@@ -0,0 +1,205 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+
+	"github.com/bufbuild/protocompile"
+	"github.com/bufbuild/protocompile/linker"
+	"github.com/bufbuild/protocompile/reporter"
+	"github.com/bufbuild/protocompile/walk"
+	"github.com/jhump/protoreflect/desc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	dpb "google.golang.org/protobuf/types/descriptorpb"
+)
+
+// Parser parses proto source files into file descriptors.
+//
+// It mirrors protoparse.Parser, but always generates source info that also
+// contains locations for the values inside option message literals, such as
+// the `get` template of a `google.api.http` rule or each `pattern` of a
+// `google.api.resource`. This allows problems to point at a single value
+// rather than the whole option.
+type Parser struct {
+	// ImportPaths are the directories searched for the files to parse and
+	// their imports. If empty, the current working directory is used.
+	ImportPaths []string
+
+	// Accessor opens source files. If nil, files are read from disk.
+	Accessor func(filename string) (io.ReadCloser, error)
+
+	// LookupImport is consulted for imports that are not found by the
+	// Accessor. The well-known types are always available.
+	LookupImport func(filename string) (*desc.FileDescriptor, error)
+
+	// ErrorReporter is called for every error found while parsing. If it
+	// returns nil, parsing continues and ParseFiles eventually fails with
+	// reporter.ErrInvalidSource. If nil, parsing stops at the first error.
+	ErrorReporter reporter.ErrorReporter
+
+	// Context, if set, cancels parsing when it is done.
+	Context context.Context
+}
+
+// ParseFiles parses the named files, returning their descriptors in the same
+// order as the given names.
+//
+// If the ErrorReporter let parsing continue past errors, ParseFiles returns
+// reporter.ErrInvalidSource along with the descriptors of the files that were
+// parsed successfully, and nil for the others.
+func (p Parser) ParseFiles(filenames ...string) ([]*desc.FileDescriptor, error) {
+	fds, err := p.ParseReflectFiles(filenames...)
+	if fds == nil {
+		return nil, err
+	}
+	var parsed []protoreflect.FileDescriptor
+	for _, fd := range fds {
+		if fd != nil {
+			parsed = append(parsed, fd)
+		}
+	}
+	wrapped, wrapErr := desc.WrapFiles(parsed)
+	if wrapErr != nil {
+		return nil, wrapErr
+	}
+	answer := make([]*desc.FileDescriptor, len(fds))
+	for i, fd := range fds {
+		if fd != nil {
+			answer[i], wrapped = wrapped[0], wrapped[1:]
+		}
+	}
+	return answer, err
+}
+
+// ParseReflectFiles parses the named files with protocompile, returning their
+// google.golang.org/protobuf descriptors in the same order as the given names.
+// Like ParseFiles, it returns the files that were parsed successfully along
+// with reporter.ErrInvalidSource.
+func (p Parser) ParseReflectFiles(filenames ...string) ([]protoreflect.FileDescriptor, error) {
+	accessor := p.Accessor
+	if accessor == nil {
+		accessor = func(name string) (io.ReadCloser, error) {
+			return os.Open(name)
+		}
+	}
+	var imports protocompile.CompositeResolver
+	if p.LookupImport != nil {
+		imports = append(imports, protocompile.ResolverFunc(func(path string) (protocompile.SearchResult, error) {
+			fd, err := p.LookupImport(path)
+			if err != nil {
+				return protocompile.SearchResult{}, err
+			}
+			return protocompile.SearchResult{Desc: fd.UnwrapFile()}, nil
+		}))
+	}
+	c := protocompile.Compiler{
+		Resolver: protocompile.CompositeResolver{
+			&protocompile.SourceResolver{ImportPaths: p.ImportPaths, Accessor: accessor},
+			protocompile.WithStandardImports(imports),
+		},
+		MaxParallelism: 1,
+		SourceInfoMode: protocompile.SourceInfoExtraComments | protocompile.SourceInfoExtraOptionLocations,
+		Reporter:       reporter.NewReporter(p.ErrorReporter, nil),
+	}
+	ctx := p.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	results, err := c.Compile(ctx, filenames...)
+	if err != nil && !errors.Is(err, reporter.ErrInvalidSource) {
+		return nil, err
+	}
+	fds := make([]protoreflect.FileDescriptor, len(filenames))
+	seen := map[string]bool{}
+	for i, res := range results {
+		if res != nil {
+			retypeExtensions(res, seen)
+			fds[i] = res
+		}
+	}
+	return fds, err
+}
+
+// retypeExtensions replaces the dynamic extensions that protocompile stores
+// in options with the statically known Go types, so that rules can read them
+// with proto.GetExtension. Unknown extensions become unrecognized fields.
+// This is what protoparse does as well.
+func retypeExtensions(fd protoreflect.FileDescriptor, seen map[string]bool) {
+	if seen[fd.Path()] {
+		return
+	}
+	seen[fd.Path()] = true
+	if res, ok := fd.(linker.Result); ok {
+		fdp := res.FileDescriptorProto()
+		fdp.Options = retypeOptions(fdp.Options)
+		_ = walk.DescriptorProtos(fdp, func(_ protoreflect.FullName, m proto.Message) error {
+			switch m := m.(type) {
+			case *dpb.DescriptorProto:
+				m.Options = retypeOptions(m.Options)
+				for _, r := range m.ExtensionRange {
+					r.Options = retypeOptions(r.Options)
+				}
+			case *dpb.FieldDescriptorProto:
+				m.Options = retypeOptions(m.Options)
+			case *dpb.OneofDescriptorProto:
+				m.Options = retypeOptions(m.Options)
+			case *dpb.EnumDescriptorProto:
+				m.Options = retypeOptions(m.Options)
+			case *dpb.EnumValueDescriptorProto:
+				m.Options = retypeOptions(m.Options)
+			case *dpb.ServiceDescriptorProto:
+				m.Options = retypeOptions(m.Options)
+			case *dpb.MethodDescriptorProto:
+				m.Options = retypeOptions(m.Options)
+			}
+			return nil
+		})
+	}
+	for i := 0; i < fd.Imports().Len(); i++ {
+		retypeExtensions(fd.Imports().Get(i).FileDescriptor, seen)
+	}
+}
+
+func retypeOptions[T proto.Message](opts T) T {
+	m := opts.ProtoReflect()
+	if !m.IsValid() {
+		return opts
+	}
+	exts := m.Type().New()
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if fd.IsExtension() {
+			exts.Set(fd, v)
+		}
+		return true
+	})
+	b, err := proto.MarshalOptions{AllowPartial: true}.Marshal(exts.Interface())
+	if err != nil || len(b) == 0 {
+		return opts
+	}
+	clone := proto.Clone(opts).ProtoReflect()
+	exts.Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		clone.Clear(fd)
+		return true
+	})
+	if err := (proto.UnmarshalOptions{AllowPartial: true, Merge: true}).Unmarshal(b, clone.Interface()); err != nil {
+		return opts
+	}
+	return clone.Interface().(T)
+}
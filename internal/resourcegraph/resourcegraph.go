@@ -0,0 +1,127 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package resourcegraph holds the resource hierarchy of an API surface, as
+// built from its `google.api.resource` and `google.api.resource_definition`
+// annotations by rules.ResourceGraph, so that it can be rendered for API
+// design reviews. It backs the `api-linter resources` command.
+package resourcegraph
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// EdgeKind describes the relationship between two resources.
+type EdgeKind string
+
+const (
+	// Parent is an edge from a parent resource to one of its children, as
+	// derived from the resource patterns.
+	Parent EdgeKind = "parent"
+
+	// Reference is an edge from a resource to a resource referenced by one of
+	// its fields through `(google.api.resource_reference).type`.
+	Reference EdgeKind = "reference"
+
+	// ChildReference is an edge from a resource to a resource referenced by
+	// one of its fields through `(google.api.resource_reference).child_type`.
+	ChildReference EdgeKind = "child_reference"
+)
+
+// Graph is the resource hierarchy of a set of proto files.
+type Graph struct {
+	Resources []*Resource `json:"resources" yaml:"resources"`
+	Edges     []*Edge     `json:"edges" yaml:"edges"`
+}
+
+// Resource is a single node in the resource graph.
+type Resource struct {
+	// Type is the resource type, e.g. "library.googleapis.com/Book".
+	Type string `json:"type" yaml:"type"`
+
+	// Patterns are the resource name patterns of the resource.
+	Patterns []string `json:"patterns" yaml:"patterns"`
+
+	// Message is the fully-qualified name of the message annotated with the
+	// resource, and empty for a `google.api.resource_definition`.
+	Message string `json:"message,omitempty" yaml:"message,omitempty"`
+
+	// File is the file in which the resource is declared.
+	File string `json:"file" yaml:"file"`
+
+	// Methods maps each standard method kind (Get, List, Create, Update and
+	// Delete) to the fully-qualified name of the RPC implementing it.
+	Methods map[string]string `json:"methods,omitempty" yaml:"methods,omitempty"`
+}
+
+// Edge is a directed relationship between two resources.
+type Edge struct {
+	From string   `json:"from" yaml:"from"`
+	To   string   `json:"to" yaml:"to"`
+	Kind EdgeKind `json:"kind" yaml:"kind"`
+
+	// Field is the fully-qualified name of the field holding the reference.
+	// It is empty for Parent edges.
+	Field string `json:"field,omitempty" yaml:"field,omitempty"`
+}
+
+// DOT renders the graph in the Graphviz DOT language.
+//
+// Parent edges are drawn solid, references dashed, and child references
+// dotted; each node lists the standard methods that exist for it.
+func (g *Graph) DOT() []byte {
+	var buf bytes.Buffer
+	buf.WriteString("digraph resources {\n")
+	buf.WriteString("  node [shape=box];\n")
+	for _, r := range g.Resources {
+		label := r.Type
+		if _, name, ok := strings.Cut(r.Type, "/"); ok && name != "" {
+			label = name
+		}
+		var methods []string
+		for _, kind := range []string{"Get", "List", "Create", "Update", "Delete"} {
+			if _, ok := r.Methods[kind]; ok {
+				methods = append(methods, kind)
+			}
+		}
+		if len(methods) > 0 {
+			label += `\n` + strings.Join(methods, ", ")
+		}
+		fmt.Fprintf(&buf, "  %s [label=%s, tooltip=%s];\n", quote(r.Type), quote(label), quote(strings.Join(r.Patterns, `\n`)))
+	}
+	for _, e := range g.Edges {
+		switch e.Kind {
+		case Parent:
+			fmt.Fprintf(&buf, "  %s -> %s;\n", quote(e.From), quote(e.To))
+		case Reference:
+			fmt.Fprintf(&buf, "  %s -> %s [style=dashed, label=%s];\n", quote(e.From), quote(e.To), quote(fieldName(e.Field)))
+		case ChildReference:
+			fmt.Fprintf(&buf, "  %s -> %s [style=dotted, label=%s];\n", quote(e.From), quote(e.To), quote(fieldName(e.Field)))
+		}
+	}
+	buf.WriteString("}\n")
+	return buf.Bytes()
+}
+
+func fieldName(fqn string) string {
+	return fqn[strings.LastIndex(fqn, ".")+1:]
+}
+
+// quote returns s as a DOT double-quoted string. Unlike Go quoting, it leaves
+// backslashes alone so that DOT escapes such as `\n` are preserved.
+func quote(s string) string {
+	return `"` + strings.ReplaceAll(s, `"`, `\"`) + `"`
+}
@@ -0,0 +1,51 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package resourcegraph
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDOT(t *testing.T) {
+	g := &Graph{
+		Resources: []*Resource{
+			{
+				Type:     "library.googleapis.com/Book",
+				Patterns: []string{"publishers/{publisher}/books/{book}"},
+				Methods:  map[string]string{"Delete": "test.Library.DeleteBook", "Get": "test.Library.GetBook"},
+			},
+			{Type: "library.googleapis.com/Publisher", Patterns: []string{"publishers/{publisher}"}},
+		},
+		Edges: []*Edge{
+			{From: "library.googleapis.com/Book", To: "library.googleapis.com/Author", Kind: Reference, Field: "test.Book.author"},
+			{From: "library.googleapis.com/Book", To: "library.googleapis.com/Shelf", Kind: ChildReference, Field: "test.Book.shelf"},
+			{From: "library.googleapis.com/Publisher", To: "library.googleapis.com/Book", Kind: Parent},
+		},
+	}
+	got := string(g.DOT())
+	for _, want := range []string{
+		"digraph resources {",
+		`"library.googleapis.com/Book" [label="Book\nGet, Delete", tooltip="publishers/{publisher}/books/{book}"];`,
+		`"library.googleapis.com/Publisher" [label="Publisher", tooltip="publishers/{publisher}"];`,
+		`"library.googleapis.com/Publisher" -> "library.googleapis.com/Book";`,
+		`"library.googleapis.com/Book" -> "library.googleapis.com/Author" [style=dashed, label="author"];`,
+		`"library.googleapis.com/Book" -> "library.googleapis.com/Shelf" [style=dotted, label="shelf"];`,
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("DOT output missing %q:\n%s", want, got)
+		}
+	}
+}
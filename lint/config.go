@@ -37,6 +37,10 @@ type Config struct {
 	ExcludedPaths []string `json:"excluded_paths" yaml:"excluded_paths"`
 	EnabledRules  []string `json:"enabled_rules" yaml:"enabled_rules"`
 	DisabledRules []string `json:"disabled_rules" yaml:"disabled_rules"`
+
+	// RuleOptions configures rules that accept options, keyed by rule name.
+	// A key may name a group of rules, like the enabled and disabled rules.
+	RuleOptions map[string]RuleOptions `json:"rule_options,omitempty" yaml:"rule_options,omitempty"`
 }
 
 // ReadConfigsFromFile reads Configs from a file.
@@ -0,0 +1,113 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lint
+
+import (
+	"sync"
+
+	"github.com/jhump/protoreflect/desc"
+	"google.golang.org/genproto/googleapis/api/serviceconfig"
+)
+
+// FileSet is the set of files visible to a single lint run: every file being
+// linted, every file given to the Linter through LookupFiles, and all of
+// their transitive dependencies. It also holds the service config of the run,
+// if any.
+//
+// Rules that need to resolve definitions across files that do not import
+// each other are given the FileSet of their run by implementing FileSetRule.
+type FileSet struct {
+	files         []*desc.FileDescriptor
+	serviceConfig *serviceconfig.Service
+
+	// valuesMu protects the values map
+	valuesMu sync.Mutex
+	values   map[interface{}]interface{}
+}
+
+// NewFileSet returns the FileSet of the given files and their transitive
+// dependencies. The Linter builds the FileSet of each run; rules only need to
+// build one to be run outside of a Linter, such as in tests.
+func NewFileSet(files ...*desc.FileDescriptor) *FileSet {
+	s := &FileSet{values: map[interface{}]interface{}{}}
+	seen := map[string]bool{}
+	var add func(f *desc.FileDescriptor)
+	add = func(f *desc.FileDescriptor) {
+		if seen[f.GetName()] {
+			return
+		}
+		seen[f.GetName()] = true
+		s.files = append(s.files, f)
+		for _, dep := range f.GetDependencies() {
+			add(dep)
+		}
+	}
+	for _, f := range files {
+		add(f)
+	}
+	return s
+}
+
+// Files returns every file in the set. If several files share a name, only
+// the first one given to the Linter is included.
+func (s *FileSet) Files() []*desc.FileDescriptor {
+	return s.files
+}
+
+// ServiceConfig returns the service config given to the Linter through the
+// ServiceConfig option, or nil if there is none.
+func (s *FileSet) ServiceConfig() *serviceconfig.Service {
+	return s.serviceConfig
+}
+
+// Value returns the value stored under the given key, calling build to
+// compute it the first time it is requested. This allows indexes over the
+// whole set to be built once per lint run and shared by every rule.
+func (s *FileSet) Value(key interface{}, build func(files []*desc.FileDescriptor) interface{}) interface{} {
+	s.valuesMu.Lock()
+	defer s.valuesMu.Unlock()
+	v, ok := s.values[key]
+	if !ok {
+		v = build(s.files)
+		s.values[key] = v
+	}
+	return v
+}
+
+// FileSetRule is a rule that needs the FileSet of the lint run. The Linter
+// runs the rule returned by WithFileSet instead of the rule itself.
+type FileSetRule interface {
+	ProtoRule
+
+	// WithFileSet returns the rule to run on the files of the FileSet.
+	WithFileSet(*FileSet) ProtoRule
+}
+
+// NewFileSetRule returns a FileSetRule that builds its rule for the FileSet
+// of each lint run. When it is not run by a Linter, such as in a test calling
+// Lint directly, it runs the rule built for a nil FileSet, which should only
+// consider the file being linted and its dependencies.
+func NewFileSetRule(build func(*FileSet) ProtoRule) FileSetRule {
+	return fileSetRule{ProtoRule: build(nil), build: build}
+}
+
+type fileSetRule struct {
+	ProtoRule
+	build func(*FileSet) ProtoRule
+}
+
+func (r fileSetRule) WithFileSet(s *FileSet) ProtoRule {
+	return r.build(s)
+}
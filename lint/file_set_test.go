@@ -0,0 +1,137 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lint
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jhump/protoreflect/desc"
+	"github.com/jhump/protoreflect/desc/builder"
+	"google.golang.org/genproto/googleapis/api/serviceconfig"
+)
+
+func TestFileSet(t *testing.T) {
+	dep, err := builder.NewFile("dep.proto").Build()
+	if err != nil {
+		t.Fatalf("Failed to build a file descriptor: %v", err)
+	}
+	depBuilder, err := builder.FromFile(dep)
+	if err != nil {
+		t.Fatalf("Failed to load a file builder: %v", err)
+	}
+	a, err := builder.NewFile("a.proto").AddDependency(depBuilder).Build()
+	if err != nil {
+		t.Fatalf("Failed to build a file descriptor: %v", err)
+	}
+	b, err := builder.NewFile("b.proto").Build()
+	if err != nil {
+		t.Fatalf("Failed to build a file descriptor: %v", err)
+	}
+	lookup, err := builder.NewFile("lookup.proto").Build()
+	if err != nil {
+		t.Fatalf("Failed to build a file descriptor: %v", err)
+	}
+
+	var sets []*FileSet
+	builds := 0
+	rule := NewFileSetRule(func(s *FileSet) ProtoRule {
+		return &FileRule{
+			Name: NewRuleName(111, "test-rule"),
+			LintFile: func(fd *desc.FileDescriptor) []Problem {
+				if s == nil {
+					return nil
+				}
+				sets = append(sets, s)
+				s.Value("key", func([]*desc.FileDescriptor) interface{} {
+					builds++
+					return nil
+				})
+				return nil
+			},
+		}
+	})
+	rules := NewRuleRegistry()
+	if err := rules.Register(111, rule); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := New(rules, nil, LookupFiles(lookup, a)).LintProtos(a, b); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(sets) != 2 || sets[0] == nil || sets[0] != sets[1] {
+		t.Fatalf("Expected both files to share one FileSet, got %v", sets)
+	}
+	var names []string
+	for _, f := range sets[0].Files() {
+		names = append(names, f.GetName())
+	}
+	want := []string{"a.proto", "dep.proto", "b.proto", "lookup.proto"}
+	if len(names) != len(want) {
+		t.Fatalf("Files() = %v, want %v", names, want)
+	}
+	for i := range want {
+		if names[i] != want[i] {
+			t.Errorf("Files() = %v, want %v", names, want)
+		}
+	}
+	if builds != 1 {
+		t.Errorf("Value() built the value %d times, want 1", builds)
+	}
+	if problems := rule.Lint(a); len(problems) != 0 || len(sets) != 2 {
+		t.Errorf("Lint() outside of a lint run got a FileSet")
+	}
+}
+
+func TestFileSet_ConcurrentRuns(t *testing.T) {
+	fd, err := builder.NewFile("test.proto").Build()
+	if err != nil {
+		t.Fatalf("Failed to build a file descriptor: %v", err)
+	}
+	// Each run lints the same file with its own service config, and should
+	// only ever see its own.
+	rules := NewRuleRegistry()
+	if err := rules.Register(111, NewFileSetRule(func(s *FileSet) ProtoRule {
+		return &FileRule{
+			Name: NewRuleName(111, "service-config"),
+			LintFile: func(f *desc.FileDescriptor) []Problem {
+				time.Sleep(time.Millisecond)
+				return []Problem{{Message: s.ServiceConfig().GetName(), Descriptor: f}}
+			},
+		}
+	})); err != nil {
+		t.Fatal(err)
+	}
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		want := fmt.Sprintf("service%d.googleapis.com", i)
+		l := New(rules, nil, ServiceConfig(&serviceconfig.Service{Name: want}))
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := l.LintProtos(fd)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			if got := resp[0].Problems[0].Message; got != want {
+				t.Errorf("Rule saw service config %q; want %q", got, want)
+			}
+		}()
+	}
+	wg.Wait()
+}
@@ -17,12 +17,14 @@
 package lint
 
 import (
-	"errors"
+	"context"
 	"fmt"
 	"runtime/debug"
-	"strings"
+	"sync"
 
 	"github.com/jhump/protoreflect/desc"
+	"google.golang.org/genproto/googleapis/api/serviceconfig"
+	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
 // Linter checks API files and returns a list of detected problems.
@@ -30,7 +32,18 @@ type Linter struct {
 	rules                 RuleRegistry
 	configs               Configs
 	debug                 bool
+	verbose               bool
 	ignoreCommentDisables bool
+	lookupFiles           []*desc.FileDescriptor
+	serviceConfig         *serviceconfig.Service
+
+	// optionsErr is the error of the rule options of the configs, if any.
+	optionsErr error
+
+	// configuredMu protects the configured map, which holds the rules
+	// configured with their options.
+	configuredMu sync.Mutex
+	configured   map[string]ProtoRule
 }
 
 // LinterOption prvoides the ability to configure the Linter.
@@ -43,6 +56,14 @@ func Debug(debug bool) LinterOption {
 	}
 }
 
+// Verbose is a LinterOption for setting if verbose mode is on. Rules created
+// with VerboseOnly only run in verbose mode.
+func Verbose(verbose bool) LinterOption {
+	return func(l *Linter) {
+		l.verbose = verbose
+	}
+}
+
 // IgnoreCommentDisables sets the flag for ignoring comments which disable rules.
 func IgnoreCommentDisables(ignoreCommentDisables bool) LinterOption {
 	return func(l *Linter) {
@@ -50,6 +71,15 @@ func IgnoreCommentDisables(ignoreCommentDisables bool) LinterOption {
 	}
 }
 
+// LookupFiles adds files, such as those loaded from descriptor sets, to the
+// FileSet of every lint run. Rules may resolve definitions in them, but they
+// are not linted themselves.
+func LookupFiles(files ...*desc.FileDescriptor) LinterOption {
+	return func(l *Linter) {
+		l.lookupFiles = append(l.lookupFiles, files...)
+	}
+}
+
 // New creates and returns a linter with the given rules and configs.
 func New(rules RuleRegistry, configs Configs, opts ...LinterOption) *Linter {
 	l := &Linter{
@@ -60,15 +90,37 @@ func New(rules RuleRegistry, configs Configs, opts ...LinterOption) *Linter {
 	for _, opt := range opts {
 		opt(l)
 	}
+	l.optionsErr = CheckRuleOptions(rules, configs)
 
 	return l
 }
 
 // LintProtos checks protobuf files and returns a list of problems or an error.
+//
+// A Linter may be used by several goroutines at once; each run has its own
+// FileSet.
 func (l *Linter) LintProtos(files ...*desc.FileDescriptor) ([]Response, error) {
+	return l.LintProtosContext(context.Background(), files...)
+}
+
+// LintProtosContext is like LintProtos, but stops with the context's error
+// once the context is done. The context is checked before each rule; a rule
+// that is already running is not interrupted.
+func (l *Linter) LintProtosContext(ctx context.Context, files ...*desc.FileDescriptor) ([]Response, error) {
+	if l.optionsErr != nil {
+		return nil, l.optionsErr
+	}
+	// Make every file in this run visible to rules resolving references
+	// across files.
+	set := NewFileSet(append(append([]*desc.FileDescriptor{}, files...), l.lookupFiles...)...)
+	set.serviceConfig = l.serviceConfig
+
 	var responses []Response
 	for _, proto := range files {
-		resp, err := l.lintFileDescriptor(proto)
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		resp, err := l.lintFileDescriptor(ctx, set, proto)
 		if err != nil {
 			return nil, err
 		}
@@ -77,58 +129,88 @@ func (l *Linter) LintProtos(files ...*desc.FileDescriptor) ([]Response, error) {
 	return responses, nil
 }
 
+// LintFiles checks protobuf files given as google.golang.org/protobuf
+// descriptors, such as those held in a protoregistry.Files, and returns a list
+// of problems or an error.
+//
+// The files are converted once for the rules that are not ReflectRules;
+// ReflectRules receive the given descriptors.
+func (l *Linter) LintFiles(files ...protoreflect.FileDescriptor) ([]Response, error) {
+	fds, err := desc.WrapFiles(files)
+	if err != nil {
+		return nil, err
+	}
+	return l.LintProtos(fds...)
+}
+
 // run executes rules on the request.
 //
 // It uses the proto file path to determine which rules will
 // be applied to the request, according to the list of Linter
 // configs.
-func (l *Linter) lintFileDescriptor(fd *desc.FileDescriptor) (Response, error) {
+//
+// FileSetRules are run with the FileSet of the run.
+//
+// A rule that panics or returns a problem without a Descriptor is reported as
+// an internal error problem of the file, and the other rules still run. The
+// context's error is returned once the context is done.
+func (l *Linter) lintFileDescriptor(ctx context.Context, set *FileSet, fd *desc.FileDescriptor) (Response, error) {
 	resp := Response{
 		FilePath: fd.GetName(),
 		Problems: []Problem{},
 	}
-	var errMessages []string
 
 	for name, rule := range l.rules {
+		if err := ctx.Err(); err != nil {
+			return Response{}, err
+		}
 		// Run the linter rule against this file, and throw away any problems
 		// which should have been disabled.
-		if l.configs.IsRuleEnabled(string(name), fd.GetName()) {
-			if problems, err := l.runAndRecoverFromPanics(rule, fd); err == nil {
-				for _, p := range problems {
-					if p.Descriptor == nil {
-						errMessages = append(errMessages, fmt.Sprintf("rule %q missing required Descriptor in returned Problem", rule.GetName()))
-						continue
-					}
-					if ruleIsEnabled(rule, p.Descriptor, p.Location, aliasMap, l.ignoreCommentDisables) {
-						p.RuleID = rule.GetName()
-						resp.Problems = append(resp.Problems, p)
-					}
-				}
-			} else {
-				errMessages = append(errMessages, err.Error())
+		informational := isVerboseOnly(rule)
+		if !l.configs.IsRuleEnabled(string(name), fd.GetName()) || (informational && !l.verbose) {
+			continue
+		}
+		rule, err := l.configuredRule(name, rule, fd.GetName())
+		if err != nil {
+			return Response{}, err
+		}
+		if r, ok := rule.(FileSetRule); ok {
+			rule = r.WithFileSet(set)
+		}
+		problems, err := l.runAndRecoverFromPanics(rule, fd)
+		if err != nil {
+			resp.Problems = append(resp.Problems, newInternalErrorProblem(fd.GetName(), rule.GetName(), err.Error()))
+			continue
+		}
+		for _, p := range problems {
+			if p.Descriptor == nil {
+				resp.Problems = append(resp.Problems, newInternalErrorProblem(fd.GetName(), rule.GetName(), "returned a problem without a Descriptor"))
+				continue
+			}
+			if ruleIsEnabled(rule, p.Descriptor, p.Location, aliasMap, l.ignoreCommentDisables) {
+				p.RuleID = rule.GetName()
+				p.Informational = informational
+				resp.Problems = append(resp.Problems, p)
 			}
 		}
 	}
 
-	var err error
-	if len(errMessages) != 0 {
-		err = errors.New(strings.Join(errMessages, "; "))
-	}
-
-	return resp, err
+	return resp, nil
 }
 
+// runAndRecoverFromPanics runs the rule, returning an error if it panics. In
+// debug mode, the error includes the stack of the panic.
 func (l *Linter) runAndRecoverFromPanics(rule ProtoRule, fd *desc.FileDescriptor) (probs []Problem, err error) {
 	defer func() {
 		if r := recover(); r != nil {
-			if l.debug {
-				debug.PrintStack()
-			}
 			if rerr, ok := r.(error); ok {
 				err = rerr
 			} else {
 				err = fmt.Errorf("panic occurred during rule execution: %v", r)
 			}
+			if l.debug {
+				err = fmt.Errorf("%w\n%s", err, debug.Stack())
+			}
 		}
 	}()
 
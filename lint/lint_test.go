@@ -15,6 +15,7 @@
 package lint
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"strings"
@@ -92,7 +93,7 @@ func TestLinter_run(t *testing.T) {
 			l := New(rules, test.configs)
 
 			// Actually run the linter.
-			resp, _ := l.lintFileDescriptor(fd)
+			resp, _ := l.lintFileDescriptor(context.Background(), NewFileSet(fd), fd)
 
 			// Assert that we got the problems we expected.
 			if !reflect.DeepEqual(resp.Problems, test.problems) {
@@ -102,7 +103,7 @@ func TestLinter_run(t *testing.T) {
 	}
 }
 
-func TestLinter_LintProtos_RulePanics(t *testing.T) {
+func TestLinter_LintProtos_RuleFailures(t *testing.T) {
 	fd, err := builder.NewFile("test.proto").Build()
 	if err != nil {
 		t.Fatalf("Failed to build the file descriptor.")
@@ -111,8 +112,10 @@ func TestLinter_LintProtos_RulePanics(t *testing.T) {
 	testAIP := 111
 
 	tests := []struct {
-		testName string
-		rule     ProtoRule
+		testName    string
+		rule        ProtoRule
+		debug       bool
+		wantMessage string
 	}{
 		{
 			testName: "Panic",
@@ -122,6 +125,7 @@ func TestLinter_LintProtos_RulePanics(t *testing.T) {
 					panic("panic")
 				},
 			},
+			wantMessage: `Internal error in rule "core::0111::panic": panic occurred during rule execution: panic`,
 		},
 		{
 			testName: "PanicError",
@@ -131,25 +135,105 @@ func TestLinter_LintProtos_RulePanics(t *testing.T) {
 					panic(fmt.Errorf("panic"))
 				},
 			},
+			wantMessage: `Internal error in rule "core::0111::panic-error": panic`,
+		},
+		{
+			testName: "PanicDebug",
+			rule: &FileRule{
+				Name: NewRuleName(testAIP, "panic"),
+				LintFile: func(_ *desc.FileDescriptor) []Problem {
+					panic("panic")
+				},
+			},
+			debug:       true,
+			wantMessage: "goroutine ",
+		},
+		{
+			testName: "MissingDescriptor",
+			rule: &FileRule{
+				Name: NewRuleName(testAIP, "missing-descriptor"),
+				LintFile: func(_ *desc.FileDescriptor) []Problem {
+					return []Problem{{Message: "foo"}}
+				},
+			},
+			wantMessage: `Internal error in rule "core::0111::missing-descriptor": returned a problem without a Descriptor`,
 		},
 	}
 
 	for _, test := range tests {
 		t.Run(test.testName, func(t *testing.T) {
 			rules := NewRuleRegistry()
-			err := rules.Register(testAIP, test.rule)
+			err := rules.Register(testAIP, test.rule, &FileRule{
+				Name: NewRuleName(testAIP, "ok"),
+				LintFile: func(f *desc.FileDescriptor) []Problem {
+					return []Problem{{Message: "ok", Descriptor: f}}
+				},
+			})
 			if err != nil {
 				t.Fatalf("Failed to create Rules: %q", err)
 			}
 
 			// Instantiate a linter with the given rule.
-			l := New(rules, nil)
+			l := New(rules, nil, Debug(test.debug))
 
-			_, err = l.LintProtos(fd)
-			if err == nil || !strings.Contains(err.Error(), "panic") {
-				t.Fatalf("Expected error with panic, got %q", err)
+			resp, err := l.LintProtos(fd)
+			if err != nil {
+				t.Fatalf("LintProtos() returned error %v; want the failure reported as a problem", err)
+			}
+			got := map[RuleName]string{}
+			for _, p := range resp[0].Problems {
+				got[p.RuleID] = p.Message
 			}
+			if got[NewRuleName(testAIP, "ok")] != "ok" {
+				t.Errorf("Got problems %v; want the problem of the other rule", got)
+			}
+			if msg := got[InternalErrorRuleName]; !strings.Contains(msg, test.wantMessage) {
+				t.Errorf("Got internal error %q; want it to contain %q", msg, test.wantMessage)
+			}
+		})
+	}
+}
+
+func TestLinter_LintProtosContext_Canceled(t *testing.T) {
+	fd, err := builder.NewFile("test.proto").Build()
+	if err != nil {
+		t.Fatalf("Failed to build the file descriptor.")
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := New(NewRuleRegistry(), nil).LintProtosContext(ctx, fd); err != context.Canceled {
+		t.Errorf("LintProtosContext() returned %v; want %v", err, context.Canceled)
+	}
+}
+
+func TestLinter_LintProtosContext_CanceledBetweenRules(t *testing.T) {
+	fd, err := builder.NewFile("test.proto").Build()
+	if err != nil {
+		t.Fatalf("Failed to build the file descriptor.")
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	// Whichever rule runs first cancels the context, so the other never runs.
+	var runs int
+	rules := NewRuleRegistry()
+	for _, name := range []string{"first", "second"} {
+		err := rules.Register(111, &FileRule{
+			Name: NewRuleName(111, name),
+			LintFile: func(f *desc.FileDescriptor) []Problem {
+				runs++
+				cancel()
+				return nil
+			},
 		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := New(rules, nil).LintProtosContext(ctx, fd); err != context.Canceled {
+		t.Errorf("LintProtosContext() returned %v; want %v", err, context.Canceled)
+	}
+	if runs != 1 {
+		t.Errorf("Got %d rules run; want 1", runs)
 	}
 }
 
@@ -16,6 +16,7 @@ package lint
 
 import (
 	"encoding/json"
+	"fmt"
 
 	"github.com/jhump/protoreflect/desc"
 	dpb "google.golang.org/protobuf/types/descriptorpb"
@@ -53,17 +54,62 @@ type Problem struct {
 	// do this is by using the helper methods in `location.go`.
 	Location *dpb.SourceCodeInfo_Location
 
+	// RelatedLocations provides other locations involved in the problem, such
+	// as the other half of a conflict or every hop of a reference cycle.
+	RelatedLocations []RelatedLocation
+
 	// RuleID provides the ID of the rule that this problem belongs to.
 	// DO NOT SET: The linter sets this automatically.
 	RuleID RuleName // FIXME: Make this private (cmd/summary_cli.go is the challenge).
 
+	// Informational is true if the problem was reported by a rule created
+	// with VerboseOnly. Such problems are not violations.
+	// DO NOT SET: The linter sets this automatically.
+	Informational bool
+
 	// The category for this problem, based on user configuration.
 	category string
 
+	// The path of the file, for problems without a Descriptor.
+	path string
+
 	//lint:ignore U1000 ignored via golint previously
 	noPositional struct{}
 }
 
+// RelatedLocation describes a secondary location that is involved in a
+// Problem, so that readers can jump to every side of it.
+type RelatedLocation struct {
+	// Message briefly describes how this location relates to the problem.
+	Message string
+
+	// Descriptor provides the descriptor at the related location. This must
+	// be set on every RelatedLocation.
+	Descriptor desc.Descriptor
+
+	// Location provides the precise related location.
+	//
+	// If unset, this defaults to the value of `Descriptor.GetSourceInfo()`.
+	Location *dpb.SourceCodeInfo_Location
+}
+
+// relatedLocation is the serialized form of a RelatedLocation.
+type relatedLocation struct {
+	Message  string       `json:"message" yaml:"message"`
+	Location fileLocation `json:"location" yaml:"location"`
+}
+
+func (r RelatedLocation) marshal() relatedLocation {
+	loc := r.Location
+	if loc == nil && r.Descriptor != nil {
+		loc = r.Descriptor.GetSourceInfo()
+	}
+	return relatedLocation{
+		Message:  r.Message,
+		Location: fileLocationFromPBLocation(loc, r.Descriptor),
+	}
+}
+
 // MarshalJSON defines how to represent a Problem in JSON.
 func (p Problem) MarshalJSON() ([]byte, error) {
 	return json.Marshal(p.marshal())
@@ -83,27 +129,79 @@ func (p Problem) marshal() interface{} {
 		loc = p.Descriptor.GetSourceInfo()
 	}
 
+	var related []relatedLocation
+	for _, r := range p.RelatedLocations {
+		related = append(related, r.marshal())
+	}
+	location := fileLocationFromPBLocation(loc, p.Descriptor)
+	if p.Descriptor == nil {
+		location.Path = p.path
+	}
+
 	// Return a marshal-able structure.
 	return struct {
-		Message    string       `json:"message" yaml:"message"`
-		Suggestion string       `json:"suggestion,omitempty" yaml:"suggestion,omitempty"`
-		Location   fileLocation `json:"location" yaml:"location"`
-		RuleID     RuleName     `json:"rule_id" yaml:"rule_id"`
-		RuleDocURI string       `json:"rule_doc_uri" yaml:"rule_doc_uri"`
-		Category   string       `json:"category,omitempty" yaml:"category,omitempty"`
+		Message          string            `json:"message" yaml:"message"`
+		Suggestion       string            `json:"suggestion,omitempty" yaml:"suggestion,omitempty"`
+		Location         fileLocation      `json:"location" yaml:"location"`
+		RelatedLocations []relatedLocation `json:"related_locations,omitempty" yaml:"related_locations,omitempty"`
+		RuleID           RuleName          `json:"rule_id" yaml:"rule_id"`
+		RuleDocURI       string            `json:"rule_doc_uri" yaml:"rule_doc_uri"`
+		Category         string            `json:"category,omitempty" yaml:"category,omitempty"`
+		Informational    bool              `json:"informational,omitempty" yaml:"informational,omitempty"`
 	}{
 		p.Message,
 		p.Suggestion,
-		fileLocationFromPBLocation(loc, p.Descriptor),
+		location,
+		related,
 		p.RuleID,
 		p.GetRuleURI(),
 		p.category,
+		p.Informational,
+	}
+}
+
+// ParseErrorRuleName is the reserved rule ID of the problems that report
+// errors found while parsing the files to lint. It cannot clash with the names
+// of registered rules, which start with a rule group and an AIP number.
+const ParseErrorRuleName RuleName = "api-linter::parse-error"
+
+// NewParseErrorProblem returns a problem reporting an error found while
+// parsing the file at the given path, at the given one-based line and column.
+//
+// Parse errors have no descriptor; the problem carries the path instead.
+func NewParseErrorProblem(path string, line, column int, message string) Problem {
+	p := Problem{
+		Message: message,
+		RuleID:  ParseErrorRuleName,
+		path:    path,
+	}
+	if line > 0 && column > 0 {
+		p.Location = &dpb.SourceCodeInfo_Location{
+			Span: []int32{int32(line - 1), int32(column - 1), int32(column)},
+		}
+	}
+	return p
+}
+
+// InternalErrorRuleName is the reserved rule ID of the problems that report
+// rules that failed, by panicking or by returning an invalid problem, while
+// linting a file. Like ParseErrorRuleName, it cannot clash with the names of
+// registered rules.
+const InternalErrorRuleName RuleName = "api-linter::internal-error"
+
+// newInternalErrorProblem returns a problem reporting that the given rule
+// failed while linting the file at the given path.
+func newInternalErrorProblem(path string, rule RuleName, message string) Problem {
+	return Problem{
+		Message: fmt.Sprintf("Internal error in rule %q: %s", rule, message),
+		RuleID:  InternalErrorRuleName,
+		path:    path,
 	}
 }
 
 // GetRuleURI returns a URI to learn more about the problem.
 func (p Problem) GetRuleURI() string {
-	return getRuleURL(string(p.RuleID), ruleURLMappings)
+	return p.RuleID.URI()
 }
 
 // position describes a one-based position in a source code file.
@@ -117,37 +215,45 @@ type position struct {
 //
 // Note: Positions are one-indexed, as a human counts lines or columns
 // in a file.
+//
+// If the location is unknown, for example because the file was loaded from a
+// descriptor set without source info, the positions are omitted and the
+// fully-qualified name of the element is given instead.
 type fileLocation struct {
-	Start position `json:"start_position" yaml:"start_position"`
-	End   position `json:"end_position" yaml:"end_position"`
-	Path  string   `json:"path" yaml:"path"`
+	Start   *position `json:"start_position,omitempty" yaml:"start_position,omitempty"`
+	End     *position `json:"end_position,omitempty" yaml:"end_position,omitempty"`
+	Path    string    `json:"path" yaml:"path"`
+	Element string    `json:"element,omitempty" yaml:"element,omitempty"`
 }
 
 // fileLocationFromPBLocation returns a new fileLocation object based on a
 // protocol buffer SourceCodeInfo_Location
 func fileLocationFromPBLocation(l *dpb.SourceCodeInfo_Location, d desc.Descriptor) fileLocation {
-	// Spans are guaranteed by protobuf to have either three or four ints.
-	span := []int32{0, 0, 1}
-	if l != nil {
-		span = l.Span
-	}
-
 	var fl fileLocation
 	if d != nil {
 		fl = fileLocation{Path: d.GetFile().GetName()}
 	}
 
+	// Spans are guaranteed by protobuf to have either three or four ints.
+	span := l.GetSpan()
+	if len(span) != 3 && len(span) != 4 {
+		if d != nil {
+			fl.Element = d.GetFullyQualifiedName()
+		}
+		return fl
+	}
+
 	// If `span` has four ints; they correspond to
 	// [start line, start column, end line, end column].
 	//
 	// We add one because spans are zero-indexed, but not to the end column
 	// because we want the ending position to be inclusive and not exclusive.
 	if len(span) == 4 {
-		fl.Start = position{
+		fl.Start = &position{
 			Line:   int(span[0]) + 1,
 			Column: int(span[1]) + 1,
 		}
-		fl.End = position{
+		fl.End = &position{
 			Line:   int(span[2]) + 1,
 			Column: int(span[3]),
 		}
@@ -159,11 +265,11 @@ func fileLocationFromPBLocation(l *dpb.SourceCodeInfo_Location, d desc.Descripto
 	//
 	// We add one because spans are zero-indexed, but not to the end column
 	// because we want the ending position to be inclusive and not exclusive.
-	fl.Start = position{
+	fl.Start = &position{
 		Line:   int(span[0]) + 1,
 		Column: int(span[1]) + 1,
 	}
-	fl.End = position{
+	fl.End = &position{
 		Line:   int(span[0]) + 1,
 		Column: int(span[2]),
 	}
@@ -19,7 +19,9 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/jhump/protoreflect/desc"
 	"github.com/jhump/protoreflect/desc/builder"
+	"google.golang.org/protobuf/proto"
 	dpb "google.golang.org/protobuf/types/descriptorpb"
 	"gopkg.in/yaml.v3"
 )
@@ -120,3 +122,87 @@ func TestProblemDescriptor(t *testing.T) {
 		})
 	}
 }
+
+func TestProblemRelatedLocations(t *testing.T) {
+	mb := builder.NewMessage("Bar")
+	builder.NewFile("bar.proto").AddMessage(mb)
+
+	m, err := mb.Build()
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	problem := &Problem{
+		Message:  "foo bar",
+		Location: &dpb.SourceCodeInfo_Location{Span: []int32{2, 0, 42}},
+		RelatedLocations: []RelatedLocation{{
+			Message:    "other half",
+			Descriptor: m,
+			Location:   &dpb.SourceCodeInfo_Location{Span: []int32{9, 2, 12}},
+		}},
+		RuleID: "core::0131",
+	}
+	serialized, err := json.Marshal(problem)
+	if err != nil {
+		t.Fatalf("Could not marshal Problem to JSON.")
+	}
+	want := `"related_locations":[{"message":"other half","location":{"start_position":{"line_number":10,"column_number":3},"end_position":{"line_number":10,"column_number":12},"path":"bar.proto"}}]`
+	if !strings.Contains(string(serialized), want) {
+		t.Errorf("Got\n%v\nExpected `%s` to be present.", string(serialized), want)
+	}
+
+	// Related locations are omitted when there are none.
+	problem.RelatedLocations = nil
+	serialized, err = yaml.Marshal(problem)
+	if err != nil {
+		t.Fatalf("Could not marshal Problem to YAML.")
+	}
+	if strings.Contains(string(serialized), "related_locations") {
+		t.Errorf("Got\n%v\nExpected related_locations to be omitted.", string(serialized))
+	}
+}
+
+func TestProblemWithoutSourceInfo(t *testing.T) {
+	f, err := desc.CreateFileDescriptor(&dpb.FileDescriptorProto{
+		Name:        proto.String("foo.proto"),
+		Package:     proto.String("test"),
+		MessageType: []*dpb.DescriptorProto{{Name: proto.String("Foo")}},
+	})
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	problem := &Problem{
+		Message:    "foo bar",
+		Descriptor: f.GetMessageTypes()[0],
+		RuleID:     "core::0131",
+	}
+	serialized, err := json.Marshal(problem)
+	if err != nil {
+		t.Fatalf("Could not marshal Problem to JSON.")
+	}
+	want := `"location":{"path":"foo.proto","element":"test.Foo"}`
+	if !strings.Contains(string(serialized), want) {
+		t.Errorf("Got\n%s\nExpected `%s` to be present.", serialized, want)
+	}
+}
+
+func TestNewParseErrorProblem(t *testing.T) {
+	tests := []struct {
+		name         string
+		line, column int
+		want         string
+	}{
+		{"Position", 3, 7, `{"message":"syntax error","location":{"start_position":{"line_number":3,"column_number":7},"end_position":{"line_number":3,"column_number":7},"path":"foo.proto"},"rule_id":"api-linter::parse-error","rule_doc_uri":""}`},
+		{"NoPosition", 0, 0, `{"message":"syntax error","location":{"path":"foo.proto"},"rule_id":"api-linter::parse-error","rule_doc_uri":""}`},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			serialized, err := json.Marshal(NewParseErrorProblem("foo.proto", test.line, test.column, "syntax error"))
+			if err != nil {
+				t.Fatalf("Could not marshal Problem to JSON: %v", err)
+			}
+			if string(serialized) != test.want {
+				t.Errorf("Got\n%s\nwant\n%s", serialized, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,145 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lint
+
+import (
+	"github.com/jhump/protoreflect/desc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// AdaptReflectRule returns a ProtoRule that runs the given ReflectRule against
+// the descriptors underlying each *desc.FileDescriptor, without converting
+// them.
+func AdaptReflectRule(r ReflectRule) ProtoRule {
+	return &reflectRuleAdapter{rule: r}
+}
+
+type reflectRuleAdapter struct {
+	rule ReflectRule
+}
+
+// GetName returns the name of the rule.
+func (a *reflectRuleAdapter) GetName() RuleName {
+	return a.rule.GetName()
+}
+
+// Lint runs the ReflectRule and converts the problems it finds.
+func (a *reflectRuleAdapter) Lint(fd *desc.FileDescriptor) []Problem {
+	var problems []Problem
+	for _, p := range a.rule.LintReflect(fd.UnwrapFile()) {
+		problem := Problem{
+			Message:    p.Message,
+			Suggestion: p.Suggestion,
+			Descriptor: wrapReflectDescriptor(fd, p.Descriptor),
+			Location:   p.Location,
+		}
+		for _, r := range p.RelatedLocations {
+			problem.RelatedLocations = append(problem.RelatedLocations, RelatedLocation{
+				Message:    r.Message,
+				Descriptor: wrapReflectDescriptor(fd, r.Descriptor),
+				Location:   r.Location,
+			})
+		}
+		problems = append(problems, problem)
+	}
+	return problems
+}
+
+// wrapReflectDescriptor returns the desc.Descriptor for d, reusing the
+// descriptors of fd and its dependencies when d belongs to one of them.
+func wrapReflectDescriptor(fd *desc.FileDescriptor, d protoreflect.Descriptor) desc.Descriptor {
+	if d == nil {
+		return nil
+	}
+	seen := map[string]bool{}
+	var find func(f *desc.FileDescriptor) desc.Descriptor
+	find = func(f *desc.FileDescriptor) desc.Descriptor {
+		if seen[f.GetName()] {
+			return nil
+		}
+		seen[f.GetName()] = true
+		if f.GetName() == d.ParentFile().Path() {
+			if _, ok := d.(protoreflect.FileDescriptor); ok {
+				return f
+			}
+			return f.FindSymbol(string(d.FullName()))
+		}
+		for _, dep := range f.GetDependencies() {
+			if found := find(dep); found != nil {
+				return found
+			}
+		}
+		return nil
+	}
+	if found := find(fd); found != nil {
+		return found
+	}
+	wrapped, err := desc.WrapDescriptor(d)
+	if err != nil {
+		return nil
+	}
+	return wrapped
+}
+
+// AdaptProtoRule returns a ReflectRule that runs the given ProtoRule, so that
+// rules that have not been ported yet keep working where a ReflectRule is
+// expected. The file is converted to a *desc.FileDescriptor on every call.
+func AdaptProtoRule(r ProtoRule) ReflectRule {
+	return &protoRuleAdapter{rule: r}
+}
+
+type protoRuleAdapter struct {
+	rule ProtoRule
+}
+
+// GetName returns the name of the rule.
+func (a *protoRuleAdapter) GetName() RuleName {
+	return a.rule.GetName()
+}
+
+// LintReflect runs the ProtoRule and converts the problems it finds.
+func (a *protoRuleAdapter) LintReflect(fd protoreflect.FileDescriptor) []ReflectProblem {
+	wrapped, err := desc.WrapFile(fd)
+	if err != nil {
+		// The linter recovers from panics in rules and reports them as
+		// internal errors.
+		panic(err)
+	}
+	var problems []ReflectProblem
+	for _, p := range a.rule.Lint(wrapped) {
+		problem := ReflectProblem{
+			Message:    p.Message,
+			Suggestion: p.Suggestion,
+			Descriptor: unwrapDescriptor(p.Descriptor),
+			Location:   p.Location,
+		}
+		for _, r := range p.RelatedLocations {
+			problem.RelatedLocations = append(problem.RelatedLocations, ReflectRelatedLocation{
+				Message:    r.Message,
+				Descriptor: unwrapDescriptor(r.Descriptor),
+				Location:   r.Location,
+			})
+		}
+		problems = append(problems, problem)
+	}
+	return problems
+}
+
+func unwrapDescriptor(d desc.Descriptor) protoreflect.Descriptor {
+	if w, ok := d.(desc.DescriptorWrapper); ok {
+		return w.Unwrap()
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lint
+
+import (
+	"testing"
+
+	"github.com/jhump/protoreflect/desc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func TestAdaptReflectRule(t *testing.T) {
+	fd, err := desc.WrapFile(reflectTestFile(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	rule := AdaptReflectRule(&ReflectMessageRule{
+		Name: NewRuleName(111, "test-rule"),
+		LintMessage: func(m protoreflect.MessageDescriptor) []ReflectProblem {
+			if m.Name() != "Book" {
+				return nil
+			}
+			return []ReflectProblem{{
+				Message:    "problem",
+				Descriptor: m,
+				RelatedLocations: []ReflectRelatedLocation{{
+					Message:    "related",
+					Descriptor: m.Fields().ByName("name"),
+				}},
+			}}
+		},
+	})
+	if got, want := rule.GetName(), NewRuleName(111, "test-rule"); got != want {
+		t.Errorf("GetName() = %q; want %q", got, want)
+	}
+	problems := rule.Lint(fd)
+	if len(problems) != 1 {
+		t.Fatalf("Got %d problems; want 1", len(problems))
+	}
+	// The problems refer to the descriptors of the linted file.
+	if got, want := problems[0].Descriptor, fd.GetMessageTypes()[0]; got != want {
+		t.Errorf("Problem descriptor is %v; want %v", got, want)
+	}
+	if got, want := problems[0].RelatedLocations[0].Descriptor, fd.GetMessageTypes()[0].FindFieldByName("name"); got != want {
+		t.Errorf("Related descriptor is %v; want %v", got, want)
+	}
+}
+
+func TestAdaptProtoRule(t *testing.T) {
+	f := reflectTestFile(t)
+	rule := AdaptProtoRule(&FieldRule{
+		Name: NewRuleName(111, "test-rule"),
+		LintField: func(f *desc.FieldDescriptor) []Problem {
+			return []Problem{{Message: "problem", Descriptor: f}}
+		},
+		OnlyIf: func(f *desc.FieldDescriptor) bool {
+			return f.GetName() == "number"
+		},
+	})
+	problems := rule.LintReflect(f)
+	if len(problems) != 1 {
+		t.Fatalf("Got %d problems; want 1", len(problems))
+	}
+	if got, want := problems[0].Descriptor.FullName(), protoreflect.FullName("test.Book.Page.number"); got != want {
+		t.Errorf("Problem descriptor is %q; want %q", got, want)
+	}
+}
+
+func TestLintFiles(t *testing.T) {
+	registry := NewRuleRegistry()
+	if err := registry.RegisterReflect(111, &ReflectEnumRule{
+		Name: NewRuleName(111, "reflect-rule"),
+		LintEnum: func(e protoreflect.EnumDescriptor) []ReflectProblem {
+			return []ReflectProblem{{Message: "reflect", Descriptor: e}}
+		},
+		OnlyIf: func(e protoreflect.EnumDescriptor) bool {
+			return e.Name() == "State"
+		},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err := registry.Register(111, &ServiceRule{
+		Name: NewRuleName(111, "proto-rule"),
+		LintService: func(s *desc.ServiceDescriptor) []Problem {
+			return []Problem{{Message: "proto", Descriptor: s}}
+		},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	responses, err := New(registry, nil).LintFiles(reflectTestFile(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(responses) != 1 || responses[0].FilePath != "test.proto" {
+		t.Fatalf("Got responses %v; want one for test.proto", responses)
+	}
+	got := map[RuleName]string{}
+	for _, p := range responses[0].Problems {
+		got[p.RuleID] = p.Descriptor.GetFullyQualifiedName()
+	}
+	for rule, want := range map[RuleName]string{
+		NewRuleName(111, "reflect-rule"): "test.State",
+		NewRuleName(111, "proto-rule"):   "test.Library",
+	} {
+		if got[rule] != want {
+			t.Errorf("Rule %s reported %q; want %q", rule, got[rule], want)
+		}
+	}
+}
+
+func TestRegisterReflect_Duplicate(t *testing.T) {
+	registry := NewRuleRegistry()
+	rule := &ReflectFileRule{Name: NewRuleName(111, "test-rule")}
+	if err := registry.RegisterReflect(111, rule, rule); err != errDuplicatedRuleName {
+		t.Errorf("RegisterReflect() returned %v; want %v", err, errDuplicatedRuleName)
+	}
+}
@@ -0,0 +1,345 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lint
+
+import (
+	"google.golang.org/protobuf/reflect/protoreflect"
+	dpb "google.golang.org/protobuf/types/descriptorpb"
+)
+
+// ReflectRule defines a lint rule that checks Google Protobuf APIs using the
+// descriptors of google.golang.org/protobuf, rather than those of
+// github.com/jhump/protoreflect/desc used by ProtoRule.
+//
+// ReflectRules are registered with RuleRegistry.RegisterReflect, and run
+// alongside ProtoRules. Use AdaptProtoRule to run a ProtoRule where a
+// ReflectRule is expected.
+//
+// Rules must only report errors in the file under which they are being run
+// (not imported files).
+type ReflectRule interface {
+	// GetName returns the name of the rule.
+	GetName() RuleName
+
+	// LintReflect accepts a FileDescriptor and lints it,
+	// returning a slice of ReflectProblem objects it finds.
+	LintReflect(protoreflect.FileDescriptor) []ReflectProblem
+}
+
+// ReflectProblem is the Problem reported by a ReflectRule.
+type ReflectProblem struct {
+	// Message provides a short description of the problem.
+	Message string
+
+	// Suggestion provides a suggested fix, if applicable.
+	Suggestion string
+
+	// Descriptor provides the descriptor related to the problem. This must be
+	// set on every ReflectProblem.
+	Descriptor protoreflect.Descriptor
+
+	// Location provides the location of the problem.
+	//
+	// If unset, this defaults to the location of `Descriptor`.
+	Location *dpb.SourceCodeInfo_Location
+
+	// RelatedLocations provides other locations involved in the problem.
+	RelatedLocations []ReflectRelatedLocation
+
+	//lint:ignore U1000 ignored via golint previously
+	noPositional struct{}
+}
+
+// ReflectRelatedLocation is the RelatedLocation of a ReflectProblem.
+type ReflectRelatedLocation struct {
+	// Message briefly describes how this location relates to the problem.
+	Message string
+
+	// Descriptor provides the descriptor at the related location. This must
+	// be set on every ReflectRelatedLocation.
+	Descriptor protoreflect.Descriptor
+
+	// Location provides the precise related location.
+	//
+	// If unset, this defaults to the location of `Descriptor`.
+	Location *dpb.SourceCodeInfo_Location
+}
+
+// ReflectFileRule defines a lint rule that checks a file as a whole.
+type ReflectFileRule struct {
+	Name RuleName
+
+	// LintFile accepts a FileDescriptor and lints it, returning a slice of
+	// ReflectProblems it finds.
+	LintFile func(protoreflect.FileDescriptor) []ReflectProblem
+
+	// OnlyIf accepts a FileDescriptor and determines whether this rule
+	// is applicable.
+	OnlyIf func(protoreflect.FileDescriptor) bool
+
+	//lint:ignore U1000 ignored via golint previously
+	noPositional struct{}
+}
+
+// GetName returns the name of the rule.
+func (r *ReflectFileRule) GetName() RuleName {
+	return r.Name
+}
+
+// LintReflect forwards the FileDescriptor to the LintFile method defined on
+// the ReflectFileRule.
+func (r *ReflectFileRule) LintReflect(fd protoreflect.FileDescriptor) []ReflectProblem {
+	if r.OnlyIf == nil || r.OnlyIf(fd) {
+		return r.LintFile(fd)
+	}
+	return nil
+}
+
+// ReflectMessageRule defines a lint rule that is run on each message in the
+// file.
+//
+// Both top-level messages and nested messages are visited.
+type ReflectMessageRule struct {
+	Name RuleName
+
+	// LintMessage accepts a MessageDescriptor and lints it, returning a slice
+	// of ReflectProblems it finds.
+	LintMessage func(protoreflect.MessageDescriptor) []ReflectProblem
+
+	// OnlyIf accepts a MessageDescriptor and determines whether this rule
+	// is applicable.
+	OnlyIf func(protoreflect.MessageDescriptor) bool
+
+	//lint:ignore U1000 ignored via golint previously
+	noPositional struct{}
+}
+
+// GetName returns the name of the rule.
+func (r *ReflectMessageRule) GetName() RuleName {
+	return r.Name
+}
+
+// LintReflect visits every message in the file, and runs `LintMessage`.
+func (r *ReflectMessageRule) LintReflect(fd protoreflect.FileDescriptor) []ReflectProblem {
+	problems := []ReflectProblem{}
+	for _, message := range allReflectMessages(fd) {
+		if r.OnlyIf == nil || r.OnlyIf(message) {
+			problems = append(problems, r.LintMessage(message)...)
+		}
+	}
+	return problems
+}
+
+// ReflectFieldRule defines a lint rule that is run on each field within a
+// file.
+type ReflectFieldRule struct {
+	Name RuleName
+
+	// LintField accepts a FieldDescriptor and lints it, returning a slice of
+	// ReflectProblems it finds.
+	LintField func(protoreflect.FieldDescriptor) []ReflectProblem
+
+	// OnlyIf accepts a FieldDescriptor and determines whether this rule
+	// is applicable.
+	OnlyIf func(protoreflect.FieldDescriptor) bool
+
+	//lint:ignore U1000 ignored via golint previously
+	noPositional struct{}
+}
+
+// GetName returns the name of the rule.
+func (r *ReflectFieldRule) GetName() RuleName {
+	return r.Name
+}
+
+// LintReflect visits every field in the file and runs `LintField`.
+func (r *ReflectFieldRule) LintReflect(fd protoreflect.FileDescriptor) []ReflectProblem {
+	problems := []ReflectProblem{}
+	for _, message := range allReflectMessages(fd) {
+		fields := message.Fields()
+		for i := 0; i < fields.Len(); i++ {
+			if r.OnlyIf == nil || r.OnlyIf(fields.Get(i)) {
+				problems = append(problems, r.LintField(fields.Get(i))...)
+			}
+		}
+	}
+	return problems
+}
+
+// ReflectServiceRule defines a lint rule that is run on each service.
+type ReflectServiceRule struct {
+	Name RuleName
+
+	// LintService accepts a ServiceDescriptor and lints it.
+	LintService func(protoreflect.ServiceDescriptor) []ReflectProblem
+
+	// OnlyIf accepts a ServiceDescriptor and determines whether this rule
+	// is applicable.
+	OnlyIf func(protoreflect.ServiceDescriptor) bool
+
+	//lint:ignore U1000 ignored via golint previously
+	noPositional struct{}
+}
+
+// GetName returns the name of the rule.
+func (r *ReflectServiceRule) GetName() RuleName {
+	return r.Name
+}
+
+// LintReflect visits every service in the file and runs `LintService`.
+func (r *ReflectServiceRule) LintReflect(fd protoreflect.FileDescriptor) []ReflectProblem {
+	problems := []ReflectProblem{}
+	services := fd.Services()
+	for i := 0; i < services.Len(); i++ {
+		if r.OnlyIf == nil || r.OnlyIf(services.Get(i)) {
+			problems = append(problems, r.LintService(services.Get(i))...)
+		}
+	}
+	return problems
+}
+
+// ReflectMethodRule defines a lint rule that is run on each method.
+type ReflectMethodRule struct {
+	Name RuleName
+
+	// LintMethod accepts a MethodDescriptor and lints it.
+	LintMethod func(protoreflect.MethodDescriptor) []ReflectProblem
+
+	// OnlyIf accepts a MethodDescriptor and determines whether this rule
+	// is applicable.
+	OnlyIf func(protoreflect.MethodDescriptor) bool
+
+	//lint:ignore U1000 ignored via golint previously
+	noPositional struct{}
+}
+
+// GetName returns the name of the rule.
+func (r *ReflectMethodRule) GetName() RuleName {
+	return r.Name
+}
+
+// LintReflect visits every method in the file and runs `LintMethod`.
+func (r *ReflectMethodRule) LintReflect(fd protoreflect.FileDescriptor) []ReflectProblem {
+	problems := []ReflectProblem{}
+	services := fd.Services()
+	for i := 0; i < services.Len(); i++ {
+		methods := services.Get(i).Methods()
+		for j := 0; j < methods.Len(); j++ {
+			if r.OnlyIf == nil || r.OnlyIf(methods.Get(j)) {
+				problems = append(problems, r.LintMethod(methods.Get(j))...)
+			}
+		}
+	}
+	return problems
+}
+
+// ReflectEnumRule defines a lint rule that is run on each enum.
+type ReflectEnumRule struct {
+	Name RuleName
+
+	// LintEnum accepts a EnumDescriptor and lints it.
+	LintEnum func(protoreflect.EnumDescriptor) []ReflectProblem
+
+	// OnlyIf accepts an EnumDescriptor and determines whether this rule
+	// is applicable.
+	OnlyIf func(protoreflect.EnumDescriptor) bool
+
+	//lint:ignore U1000 ignored via golint previously
+	noPositional struct{}
+}
+
+// GetName returns the name of the rule.
+func (r *ReflectEnumRule) GetName() RuleName {
+	return r.Name
+}
+
+// LintReflect visits every enum in the file and runs `LintEnum`.
+func (r *ReflectEnumRule) LintReflect(fd protoreflect.FileDescriptor) []ReflectProblem {
+	problems := []ReflectProblem{}
+	for _, enum := range allReflectEnums(fd) {
+		if r.OnlyIf == nil || r.OnlyIf(enum) {
+			problems = append(problems, r.LintEnum(enum)...)
+		}
+	}
+	return problems
+}
+
+// ReflectEnumValueRule defines a lint rule that is run on each enum value.
+type ReflectEnumValueRule struct {
+	Name RuleName
+
+	// LintEnumValue accepts a EnumValueDescriptor and lints it.
+	LintEnumValue func(protoreflect.EnumValueDescriptor) []ReflectProblem
+
+	// OnlyIf accepts an EnumValueDescriptor and determines whether this rule
+	// is applicable.
+	OnlyIf func(protoreflect.EnumValueDescriptor) bool
+
+	//lint:ignore U1000 ignored via golint previously
+	noPositional struct{}
+}
+
+// GetName returns the name of the rule.
+func (r *ReflectEnumValueRule) GetName() RuleName {
+	return r.Name
+}
+
+// LintReflect visits every enum value in the file and runs `LintEnumValue`.
+func (r *ReflectEnumValueRule) LintReflect(fd protoreflect.FileDescriptor) []ReflectProblem {
+	problems := []ReflectProblem{}
+	for _, enum := range allReflectEnums(fd) {
+		values := enum.Values()
+		for i := 0; i < values.Len(); i++ {
+			if r.OnlyIf == nil || r.OnlyIf(values.Get(i)) {
+				problems = append(problems, r.LintEnumValue(values.Get(i))...)
+			}
+		}
+	}
+	return problems
+}
+
+// allReflectMessages returns a slice with every message (not just top-level
+// messages) in the file, except the synthetic map entry messages.
+func allReflectMessages(fd protoreflect.FileDescriptor) []protoreflect.MessageDescriptor {
+	var messages []protoreflect.MessageDescriptor
+	var add func(protoreflect.MessageDescriptors)
+	add = func(ms protoreflect.MessageDescriptors) {
+		for i := 0; i < ms.Len(); i++ {
+			m := ms.Get(i)
+			if !m.IsMapEntry() {
+				messages = append(messages, m)
+			}
+			add(m.Messages())
+		}
+	}
+	add(fd.Messages())
+	return messages
+}
+
+// allReflectEnums returns a slice with every enum (not just top-level enums)
+// in the file.
+func allReflectEnums(fd protoreflect.FileDescriptor) []protoreflect.EnumDescriptor {
+	var enums []protoreflect.EnumDescriptor
+	appendEnums := func(es protoreflect.EnumDescriptors) {
+		for i := 0; i < es.Len(); i++ {
+			enums = append(enums, es.Get(i))
+		}
+	}
+	appendEnums(fd.Enums())
+	for _, m := range allReflectMessages(fd) {
+		appendEnums(m.Enums())
+	}
+	return enums
+}
@@ -0,0 +1,119 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lint
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/jhump/protoreflect/desc/builder"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// reflectTestFile builds a file with a service, nested messages, a map field
+// and nested enums.
+func reflectTestFile(t *testing.T) protoreflect.FileDescriptor {
+	book := builder.NewMessage("Book").
+		AddField(builder.NewField("name", builder.FieldTypeString())).
+		AddField(builder.NewMapField("labels", builder.FieldTypeString(), builder.FieldTypeString())).
+		AddNestedMessage(builder.NewMessage("Page").AddField(builder.NewField("number", builder.FieldTypeInt32()))).
+		AddNestedEnum(builder.NewEnum("Format").AddValue(builder.NewEnumValue("FORMAT_UNSPECIFIED")))
+	fd, err := builder.NewFile("test.proto").SetPackageName("test").
+		AddMessage(book).
+		AddEnum(builder.NewEnum("State").AddValue(builder.NewEnumValue("STATE_UNSPECIFIED")).AddValue(builder.NewEnumValue("ACTIVE"))).
+		AddService(builder.NewService("Library").AddMethod(
+			builder.NewMethod("GetBook", builder.RpcTypeMessage(book, false), builder.RpcTypeMessage(book, false)),
+		)).
+		Build()
+	if err != nil {
+		t.Fatalf("Failed to build a file descriptor: %v", err)
+	}
+	return fd.UnwrapFile()
+}
+
+// names returns the sorted full names of the problems' descriptors.
+func names(problems []ReflectProblem) []string {
+	var answer []string
+	for _, p := range problems {
+		answer = append(answer, string(p.Descriptor.FullName()))
+	}
+	sort.Strings(answer)
+	return answer
+}
+
+// report returns a single problem for the given descriptor.
+func report(d protoreflect.Descriptor) []ReflectProblem {
+	return []ReflectProblem{{Message: "visited", Descriptor: d}}
+}
+
+func TestReflectRules(t *testing.T) {
+	fd := reflectTestFile(t)
+	for _, test := range []struct {
+		name string
+		rule ReflectRule
+		want []string
+	}{
+		{
+			"File",
+			&ReflectFileRule{LintFile: func(f protoreflect.FileDescriptor) []ReflectProblem { return report(f) }},
+			[]string{"test"},
+		},
+		{
+			"Message",
+			&ReflectMessageRule{LintMessage: func(m protoreflect.MessageDescriptor) []ReflectProblem { return report(m) }},
+			[]string{"test.Book", "test.Book.Page"},
+		},
+		{
+			"MessageOnlyIf",
+			&ReflectMessageRule{
+				LintMessage: func(m protoreflect.MessageDescriptor) []ReflectProblem { return report(m) },
+				OnlyIf:      func(m protoreflect.MessageDescriptor) bool { return m.Name() == "Page" },
+			},
+			[]string{"test.Book.Page"},
+		},
+		{
+			"Field",
+			&ReflectFieldRule{LintField: func(f protoreflect.FieldDescriptor) []ReflectProblem { return report(f) }},
+			[]string{"test.Book.Page.number", "test.Book.labels", "test.Book.name"},
+		},
+		{
+			"Service",
+			&ReflectServiceRule{LintService: func(s protoreflect.ServiceDescriptor) []ReflectProblem { return report(s) }},
+			[]string{"test.Library"},
+		},
+		{
+			"Method",
+			&ReflectMethodRule{LintMethod: func(m protoreflect.MethodDescriptor) []ReflectProblem { return report(m) }},
+			[]string{"test.Library.GetBook"},
+		},
+		{
+			"Enum",
+			&ReflectEnumRule{LintEnum: func(e protoreflect.EnumDescriptor) []ReflectProblem { return report(e) }},
+			[]string{"test.Book.Format", "test.State"},
+		},
+		{
+			"EnumValue",
+			&ReflectEnumValueRule{LintEnumValue: func(v protoreflect.EnumValueDescriptor) []ReflectProblem { return report(v) }},
+			[]string{"test.ACTIVE", "test.Book.FORMAT_UNSPECIFIED", "test.STATE_UNSPECIFIED"},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			if diff := cmp.Diff(test.want, names(test.rule.LintReflect(fd))); diff != "" {
+				t.Errorf("Visited descriptors mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
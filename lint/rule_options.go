@@ -0,0 +1,213 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lint
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// RuleOptions holds the options of a rule, as read from a config file.
+type RuleOptions map[string]interface{}
+
+// ConfigurableRule is a rule that accepts options from the configs.
+type ConfigurableRule interface {
+	ProtoRule
+
+	// WithOptions returns the rule configured with the given options, or an
+	// error if they are invalid.
+	WithOptions(RuleOptions) (ProtoRule, error)
+}
+
+// RuleOptions returns the options of a rule for a file path. The options of
+// every config that matches the path and the rule are merged, with later
+// configs taking precedence.
+func (configs Configs) RuleOptions(rule string, path string) RuleOptions {
+	var opts RuleOptions
+	for _, c := range configs {
+		if !c.matchPath(path) {
+			continue
+		}
+		opts = mergeRuleOptions(opts, c.ruleOptions(rule))
+	}
+	return opts
+}
+
+// ruleOptions returns the options that the config gives to a rule, or nil if
+// it gives none.
+func (c Config) ruleOptions(rule string) RuleOptions {
+	var opts RuleOptions
+	for name, o := range c.RuleOptions {
+		if matchRule(rule, name) {
+			opts = mergeRuleOptions(opts, o)
+		}
+	}
+	return opts
+}
+
+func mergeRuleOptions(opts, other RuleOptions) RuleOptions {
+	if other == nil {
+		return opts
+	}
+	if opts == nil {
+		opts = RuleOptions{}
+	}
+	for k, v := range other {
+		opts[k] = v
+	}
+	return opts
+}
+
+// CheckRuleOptions returns an error if the rule options of the configs cannot
+// be used: if a key matches no rule, such as a misspelled rule name, if it
+// only matches rules that do not accept options, or if a rule rejects the
+// options. A key naming a group of rules is fine as long as one of them
+// accepts options.
+//
+// New checks the rule options of its configs, and the Linter returns this
+// error instead of linting.
+func CheckRuleOptions(rules RuleRegistry, configs Configs) error {
+	names := make([]string, 0, len(rules))
+	for name := range rules {
+		names = append(names, string(name))
+	}
+	sort.Strings(names)
+	var errs []error
+	for _, c := range configs {
+		keys := make([]string, 0, len(c.RuleOptions))
+		for key := range c.RuleOptions {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			matched, configurable := false, false
+			for _, name := range names {
+				if !matchRule(name, key) {
+					continue
+				}
+				matched = true
+				if _, ok := rules[RuleName(name)].(ConfigurableRule); ok {
+					configurable = true
+				}
+			}
+			switch {
+			case !matched:
+				errs = append(errs, fmt.Errorf("rule_options: %q matches no rule", key))
+			case !configurable:
+				errs = append(errs, fmt.Errorf("rule_options: no rule matching %q accepts options", key))
+			}
+		}
+		for _, name := range names {
+			configurable, ok := rules[RuleName(name)].(ConfigurableRule)
+			if !ok {
+				continue
+			}
+			if opts := c.ruleOptions(name); opts != nil {
+				if _, err := configurable.WithOptions(opts); err != nil {
+					errs = append(errs, fmt.Errorf("rule_options: invalid options for %s: %w", name, err))
+				}
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// configuredRule returns the rule configured with its options for the file
+// path, or the rule itself if it has no options. Rules are configured once
+// for each set of configs that give them options.
+func (l *Linter) configuredRule(name RuleName, rule ProtoRule, path string) (ProtoRule, error) {
+	configurable, ok := rule.(ConfigurableRule)
+	if !ok {
+		return rule, nil
+	}
+	var opts RuleOptions
+	key := string(name)
+	for i, c := range l.configs {
+		if !c.matchPath(path) {
+			continue
+		}
+		if o := c.ruleOptions(string(name)); o != nil {
+			opts = mergeRuleOptions(opts, o)
+			key += fmt.Sprintf(",%d", i)
+		}
+	}
+	if opts == nil {
+		return rule, nil
+	}
+
+	l.configuredMu.Lock()
+	defer l.configuredMu.Unlock()
+	if configured, ok := l.configured[key]; ok {
+		return configured, nil
+	}
+	configured, err := configurable.WithOptions(opts)
+	if err != nil {
+		return nil, fmt.Errorf("invalid options for %s: %w", name, err)
+	}
+	if l.configured == nil {
+		l.configured = map[string]ProtoRule{}
+	}
+	l.configured[key] = configured
+	return configured, nil
+}
+
+// StringSlice returns the option with the given key as a list of strings. It
+// returns nil if the option is not set, and an error if it is not a list of
+// strings.
+func (o RuleOptions) StringSlice(key string) ([]string, error) {
+	v, ok := o[key]
+	if !ok {
+		return nil, nil
+	}
+	switch v := v.(type) {
+	case []string:
+		return v, nil
+	case []interface{}:
+		values := make([]string, 0, len(v))
+		for _, e := range v {
+			s, ok := e.(string)
+			if !ok {
+				return nil, fmt.Errorf("option %q must be a list of strings, got element %v", key, e)
+			}
+			values = append(values, s)
+		}
+		return values, nil
+	}
+	return nil, fmt.Errorf("option %q must be a list of strings, got %v", key, v)
+}
+
+// Decode stores the option with the given key in the value pointed to by v,
+// as encoding/json would, and leaves v unchanged if the option is not set. It
+// returns an error if the option does not fit v, including if it has a field
+// that v does not.
+func (o RuleOptions) Decode(key string, v interface{}) error {
+	value, ok := o[key]
+	if !ok {
+		return nil
+	}
+	b, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("option %q: %v", key, err)
+	}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(v); err != nil {
+		return fmt.Errorf("option %q: %v", key, err)
+	}
+	return nil
+}
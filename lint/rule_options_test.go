@@ -0,0 +1,272 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lint
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/jhump/protoreflect/desc"
+	"github.com/jhump/protoreflect/desc/builder"
+)
+
+func TestConfigs_RuleOptions(t *testing.T) {
+	configs := Configs{
+		{RuleOptions: map[string]RuleOptions{"core::0147": {"names": []string{"ssn"}, "size": 1}}},
+		{
+			IncludedPaths: []string{"a/**/*.proto"},
+			RuleOptions:   map[string]RuleOptions{"core::0147::input-only": {"size": 2}},
+		},
+		{RuleOptions: map[string]RuleOptions{"core::0148": {"other": true}}},
+	}
+	for _, test := range []struct {
+		name, rule, path string
+		want             RuleOptions
+	}{
+		{"Group", "core::0147::http-path", "b.proto", RuleOptions{"names": []string{"ssn"}, "size": 1}},
+		{"Merged", "core::0147::input-only", "a/b/c.proto", RuleOptions{"names": []string{"ssn"}, "size": 2}},
+		{"PathNotMatched", "core::0147::input-only", "b.proto", RuleOptions{"names": []string{"ssn"}, "size": 1}},
+		{"None", "core::0140::lower-snake", "b.proto", nil},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			if diff := cmp.Diff(test.want, configs.RuleOptions(test.rule, test.path)); diff != "" {
+				t.Errorf("RuleOptions() got diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestReadConfigs_RuleOptions(t *testing.T) {
+	configs, err := ReadConfigsYAML(strings.NewReader(`
+- rule_options:
+    core::0147:
+      names: [ssn, pin]
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := configs.RuleOptions("core::0147::input-only", "a.proto").StringSlice("names")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]string{"ssn", "pin"}, got); diff != "" {
+		t.Errorf("StringSlice() got diff (-want +got):\n%s", diff)
+	}
+}
+
+func TestRuleOptions_StringSlice(t *testing.T) {
+	opts := RuleOptions{"names": []interface{}{"a", "b"}, "mixed": []interface{}{"a", 1}, "scalar": "a"}
+	if got, err := opts.StringSlice("names"); err != nil || !cmp.Equal(got, []string{"a", "b"}) {
+		t.Errorf("StringSlice(names) = %v, %v; want [a b]", got, err)
+	}
+	if got, err := opts.StringSlice("missing"); err != nil || got != nil {
+		t.Errorf("StringSlice(missing) = %v, %v; want nil", got, err)
+	}
+	for _, key := range []string{"mixed", "scalar"} {
+		if _, err := opts.StringSlice(key); err == nil {
+			t.Errorf("StringSlice(%s) succeeded; want an error", key)
+		}
+	}
+}
+
+func TestRuleOptions_Decode(t *testing.T) {
+	type point struct {
+		X int    `json:"x"`
+		Y string `json:"y"`
+	}
+	configs, err := ReadConfigsYAML(strings.NewReader(`
+- rule_options:
+    core::0111:
+      points:
+        - x: 1
+          y: a
+      other:
+        - z: 1
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	opts := configs.RuleOptions("core::0111::options", "a.proto")
+	var got []point
+	if err := opts.Decode("points", &got); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]point{{1, "a"}}, got); diff != "" {
+		t.Errorf("Decode(points) got diff (-want +got):\n%s", diff)
+	}
+	if err := opts.Decode("missing", &got); err != nil || len(got) != 1 {
+		t.Errorf("Decode(missing) = %v, %v; want nil and no change", got, err)
+	}
+	if err := opts.Decode("other", &got); err == nil {
+		t.Error("Decode(other) succeeded; want an error for the unknown field")
+	}
+}
+
+// optionRule reports its "message" option.
+type optionRule struct {
+	*FileRule
+}
+
+func (r optionRule) WithOptions(opts RuleOptions) (ProtoRule, error) {
+	msgs, err := opts.StringSlice("message")
+	if err != nil {
+		return nil, err
+	}
+	return &FileRule{
+		Name: r.Name,
+		LintFile: func(f *desc.FileDescriptor) []Problem {
+			return []Problem{{Message: strings.Join(msgs, " "), Descriptor: f}}
+		},
+	}, nil
+}
+
+func TestLinter_RuleOptions(t *testing.T) {
+	fd, err := builder.NewFile("test.proto").Build()
+	if err != nil {
+		t.Fatalf("Failed to build the file descriptor.")
+	}
+	name := NewRuleName(111, "options")
+	rules := NewRuleRegistry()
+	if err := rules.Register(111, optionRule{&FileRule{
+		Name: name,
+		LintFile: func(f *desc.FileDescriptor) []Problem {
+			return []Problem{{Message: "default", Descriptor: f}}
+		},
+	}}); err != nil {
+		t.Fatal(err)
+	}
+	for _, test := range []struct {
+		testName string
+		opts     RuleOptions
+		want     string
+	}{
+		{"Default", nil, "default"},
+		{"Configured", RuleOptions{"message": []interface{}{"configured"}}, "configured"},
+	} {
+		t.Run(test.testName, func(t *testing.T) {
+			configs := Configs{{RuleOptions: map[string]RuleOptions{string(name): test.opts}}}
+			resp, err := New(rules, configs).LintProtos(fd)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(resp[0].Problems) != 1 {
+				t.Fatalf("Got problems %v; want one", resp[0].Problems)
+			}
+			if p := resp[0].Problems[0]; p.RuleID != name || p.Message != test.want {
+				t.Errorf("Got problem %s %q; want %s %q", p.RuleID, p.Message, name, test.want)
+			}
+		})
+	}
+}
+
+// countingRule counts the calls to WithOptions.
+type countingRule struct {
+	*FileRule
+	calls *int
+}
+
+func (r countingRule) WithOptions(opts RuleOptions) (ProtoRule, error) {
+	*r.calls++
+	return r.FileRule, nil
+}
+
+func TestLinter_RuleOptionsConfiguredOnce(t *testing.T) {
+	var fds []*desc.FileDescriptor
+	for _, name := range []string{"a.proto", "b.proto", "c/d.proto"} {
+		fd, err := builder.NewFile(name).Build()
+		if err != nil {
+			t.Fatalf("Failed to build the file descriptor.")
+		}
+		fds = append(fds, fd)
+	}
+	calls := 0
+	rules := NewRuleRegistry()
+	if err := rules.Register(111, countingRule{&FileRule{
+		Name:     NewRuleName(111, "options"),
+		LintFile: func(f *desc.FileDescriptor) []Problem { return nil },
+	}, &calls}); err != nil {
+		t.Fatal(err)
+	}
+	configs := Configs{
+		{RuleOptions: map[string]RuleOptions{"core::0111": {"a": 1}}},
+		{IncludedPaths: []string{"c/*.proto"}, RuleOptions: map[string]RuleOptions{"core::0111": {"b": 2}}},
+	}
+	l := New(rules, configs)
+	calls = 0
+	for i := 0; i < 2; i++ {
+		if _, err := l.LintProtos(fds...); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Once for a.proto and b.proto, and once for c/d.proto.
+	if calls != 2 {
+		t.Errorf("WithOptions called %d times; want 2", calls)
+	}
+}
+
+func TestCheckRuleOptions(t *testing.T) {
+	rules := NewRuleRegistry()
+	if err := rules.Register(111, optionRule{&FileRule{
+		Name:     NewRuleName(111, "options"),
+		LintFile: func(f *desc.FileDescriptor) []Problem { return nil },
+	}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := rules.Register(112, &FileRule{
+		Name:     NewRuleName(112, "no-options"),
+		LintFile: func(f *desc.FileDescriptor) []Problem { return nil },
+	}); err != nil {
+		t.Fatal(err)
+	}
+	valid := RuleOptions{"message": []interface{}{"configured"}}
+	for _, test := range []struct {
+		testName string
+		key      string
+		opts     RuleOptions
+		want     string
+	}{
+		{"Rule", "core::0111::options", valid, ""},
+		{"Group", "core", valid, ""},
+		{"Invalid", "core::0111::options", RuleOptions{"message": 1}, "invalid options for core::0111::options"},
+		{"InvalidGroup", "core", RuleOptions{"message": 1}, "invalid options for core::0111::options"},
+		{"UnknownRule", "core::111", valid, `"core::111" matches no rule`},
+		{"NotConfigurable", "core::0112::no-options", valid, `no rule matching "core::0112::no-options" accepts options`},
+		{"NotConfigurableGroup", "core::0112", valid, `no rule matching "core::0112" accepts options`},
+	} {
+		t.Run(test.testName, func(t *testing.T) {
+			configs := Configs{{RuleOptions: map[string]RuleOptions{test.key: test.opts}}}
+			err := CheckRuleOptions(rules, configs)
+			if test.want == "" {
+				if err != nil {
+					t.Errorf("CheckRuleOptions() = %v; want nil", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), test.want) {
+				t.Errorf("CheckRuleOptions() = %v; want an error containing %q", err, test.want)
+			}
+			// The linter fails every run rather than linting without the
+			// options.
+			fd, buildErr := builder.NewFile("test.proto").Build()
+			if buildErr != nil {
+				t.Fatalf("Failed to build the file descriptor.")
+			}
+			if _, lintErr := New(rules, configs).LintProtos(fd); lintErr == nil || lintErr.Error() != err.Error() {
+				t.Errorf("LintProtos() = %v; want %v", lintErr, err)
+			}
+		})
+	}
+}
@@ -50,6 +50,17 @@ func (r RuleRegistry) Register(aip int, rules ...ProtoRule) error {
 	return nil
 }
 
+// RegisterReflect registers the list of ReflectRules of the same AIP, to be
+// run alongside the ProtoRules.
+// Return an error if any of the rules is found duplicate in the registry.
+func (r RuleRegistry) RegisterReflect(aip int, rules ...ReflectRule) error {
+	adapted := make([]ProtoRule, 0, len(rules))
+	for _, rl := range rules {
+		adapted = append(adapted, AdaptReflectRule(rl))
+	}
+	return r.Register(aip, adapted...)
+}
+
 // NewRuleRegistry creates a new rule registry.
 func NewRuleRegistry() RuleRegistry {
 	return make(RuleRegistry)
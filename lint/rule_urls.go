@@ -44,6 +44,12 @@ func groupURL(ruleName, groupName string) string {
 	return base + path
 }
 
+// URI returns a URI to learn more about the rule, or an empty string if the
+// rule is not documented on linter.aip.dev.
+func (r RuleName) URI() string {
+	return getRuleURL(string(r), ruleURLMappings)
+}
+
 func getRuleURL(ruleName string, nameURLMappings []func(string) string) string {
 	for i := len(nameURLMappings) - 1; i >= 0; i-- {
 		if url := nameURLMappings[i](ruleName); url != "" {
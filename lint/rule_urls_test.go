@@ -94,3 +94,19 @@ func TestGetRuleURL(t *testing.T) {
 		})
 	}
 }
+
+func TestRuleNameURI(t *testing.T) {
+	tests := []struct {
+		ruleName RuleName
+		ruleURL  string
+	}{
+		{"core::0122::camel-case-uris", "https://linter.aip.dev/122/camel-case-uris"},
+		{"cloud::25164::foo-bar", "https://linter.aip.dev/25164/foo-bar"},
+		{"unknown::0122::foo", ""},
+	}
+	for _, test := range tests {
+		if got := test.ruleName.URI(); got != test.ruleURL {
+			t.Errorf("%s.URI() got %s, but want %s", test.ruleName, got, test.ruleURL)
+		}
+	}
+}
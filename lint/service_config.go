@@ -0,0 +1,69 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lint
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"google.golang.org/genproto/googleapis/api/serviceconfig"
+	"google.golang.org/protobuf/encoding/protojson"
+	"gopkg.in/yaml.v3"
+)
+
+// ServiceConfig is a LinterOption for setting the service config
+// (`google.api.Service`) of the APIs being linted. Rules can find it with
+// FileSet.ServiceConfig.
+func ServiceConfig(cfg *serviceconfig.Service) LinterOption {
+	return func(l *Linter) {
+		l.serviceConfig = cfg
+	}
+}
+
+// ReadServiceConfigFromFile reads a service config from a YAML or JSON file.
+func ReadServiceConfigFromFile(path string) (*serviceconfig.Service, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading service config: %w", err)
+	}
+	defer f.Close()
+	return ReadServiceConfig(f)
+}
+
+// ReadServiceConfig reads a service config in YAML or JSON, the way it is
+// given to API tooling. Unknown fields, such as the `type` of YAML service
+// configs, are ignored.
+func ReadServiceConfig(f io.Reader) (*serviceconfig.Service, error) {
+	b, err := io.ReadAll(f)
+	if err != nil {
+		return nil, err
+	}
+	// YAML is a superset of JSON, so both are converted to JSON, which
+	// protojson can read with either the proto or the JSON field names.
+	var v interface{}
+	if err := yaml.Unmarshal(b, &v); err != nil {
+		return nil, fmt.Errorf("reading service config: %w", err)
+	}
+	if b, err = json.Marshal(v); err != nil {
+		return nil, fmt.Errorf("reading service config: %w", err)
+	}
+	cfg := &serviceconfig.Service{}
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(b, cfg); err != nil {
+		return nil, fmt.Errorf("reading service config: %w", err)
+	}
+	return cfg, nil
+}
@@ -0,0 +1,110 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lint
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/jhump/protoreflect/desc"
+	"github.com/jhump/protoreflect/desc/builder"
+	"google.golang.org/genproto/googleapis/api/serviceconfig"
+)
+
+func TestReadServiceConfig(t *testing.T) {
+	for _, test := range []struct {
+		name string
+		src  string
+	}{
+		{"YAML", `
+type: google.api.Service
+config_version: 3
+name: library.googleapis.com
+publishing:
+  method_settings:
+  - selector: google.example.library.v1.Library.CreateBook
+    auto_populated_fields:
+    - request_id
+`},
+		{"JSON", `{
+  "name": "library.googleapis.com",
+  "publishing": {
+    "methodSettings": [{
+      "selector": "google.example.library.v1.Library.CreateBook",
+      "autoPopulatedFields": ["request_id"]
+    }]
+  }
+}`},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			cfg, err := ReadServiceConfig(strings.NewReader(test.src))
+			if err != nil {
+				t.Fatalf("ReadServiceConfig got an error: %v", err)
+			}
+			if got, want := cfg.GetName(), "library.googleapis.com"; got != want {
+				t.Errorf("Name got %q, want %q", got, want)
+			}
+			settings := cfg.GetPublishing().GetMethodSettings()
+			if len(settings) != 1 || settings[0].GetSelector() != "google.example.library.v1.Library.CreateBook" || strings.Join(settings[0].GetAutoPopulatedFields(), ",") != "request_id" {
+				t.Errorf("MethodSettings got %v", settings)
+			}
+		})
+	}
+}
+
+func TestReadServiceConfig_Invalid(t *testing.T) {
+	for _, src := range []string{"name: [", "name: 3", "publishing: {method_settings: 1}"} {
+		if _, err := ReadServiceConfig(strings.NewReader(src)); err == nil {
+			t.Errorf("ReadServiceConfig(%q) succeeded; want an error", src)
+		}
+	}
+}
+
+func TestLinter_ServiceConfig(t *testing.T) {
+	fd, err := builder.NewFile("test.proto").Build()
+	if err != nil {
+		t.Fatalf("Failed to build the file descriptor.")
+	}
+	rules := NewRuleRegistry()
+	if err := rules.Register(111, NewFileSetRule(func(s *FileSet) ProtoRule {
+		return &FileRule{
+			Name: NewRuleName(111, "service-config"),
+			LintFile: func(f *desc.FileDescriptor) []Problem {
+				return []Problem{{Message: s.ServiceConfig().GetName(), Descriptor: f}}
+			},
+		}
+	})); err != nil {
+		t.Fatal(err)
+	}
+	cfg := &serviceconfig.Service{Name: "library.googleapis.com"}
+	for _, test := range []struct {
+		name string
+		opts []LinterOption
+		want string
+	}{
+		{"ServiceConfig", []LinterOption{ServiceConfig(cfg)}, "library.googleapis.com"},
+		{"None", nil, ""},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			resp, err := New(rules, nil, test.opts...).LintProtos(fd)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := resp[0].Problems[0].Message; got != test.want {
+				t.Errorf("Got the service config %q; want %q", got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,33 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lint
+
+// verboseRule is a rule that only runs in verbose mode.
+type verboseRule struct {
+	ProtoRule
+}
+
+// VerboseOnly returns a rule that runs only when the linter is in verbose
+// mode, such as one reporting informational problems that are not
+// violations. The problems it reports are marked as Informational.
+func VerboseOnly(rule ProtoRule) ProtoRule {
+	return verboseRule{rule}
+}
+
+// isVerboseOnly returns true if the rule only runs in verbose mode.
+func isVerboseOnly(rule ProtoRule) bool {
+	_, ok := rule.(verboseRule)
+	return ok
+}
@@ -0,0 +1,61 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lint
+
+import (
+	"testing"
+
+	"github.com/jhump/protoreflect/desc"
+	"github.com/jhump/protoreflect/desc/builder"
+)
+
+func TestLinter_VerboseOnly(t *testing.T) {
+	fd, err := builder.NewFile("test.proto").Build()
+	if err != nil {
+		t.Fatalf("Failed to build the file descriptor.")
+	}
+	rules := NewRuleRegistry()
+	if err := rules.Register(111, VerboseOnly(&FileRule{
+		Name: NewRuleName(111, "verbose"),
+		LintFile: func(f *desc.FileDescriptor) []Problem {
+			return []Problem{{Message: "informational", Descriptor: f}}
+		},
+	})); err != nil {
+		t.Fatal(err)
+	}
+	for _, test := range []struct {
+		name    string
+		verbose bool
+		want    int
+	}{
+		{"Verbose", true, 1},
+		{"NotVerbose", false, 0},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			resp, err := New(rules, nil, Verbose(test.verbose)).LintProtos(fd)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := len(resp[0].Problems); got != test.want {
+				t.Errorf("Got %d problems; want %d", got, test.want)
+			}
+			for _, p := range resp[0].Problems {
+				if !p.Informational {
+					t.Errorf("Got problem %v; want it to be informational", p)
+				}
+			}
+		})
+	}
+}
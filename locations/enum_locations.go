@@ -0,0 +1,28 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package locations
+
+import (
+	"github.com/jhump/protoreflect/desc"
+	dpb "google.golang.org/protobuf/types/descriptorpb"
+)
+
+// EnumType returns the precise location of an enum's `features.enum_type`
+// option, which makes it open or closed in files using Protobuf Editions.
+func EnumType(e *desc.EnumDescriptor) *dpb.SourceCodeInfo_Location {
+	// EnumDescriptor.options == 3, EnumOptions.features == 7,
+	// FeatureSet.enum_type == 2
+	return pathLocation(e, 3, 7, 2)
+}
@@ -0,0 +1,49 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package locations
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/jhump/protoreflect/desc"
+)
+
+func TestEnumType(t *testing.T) {
+	f := parse(t, `
+		edition = "2023";
+		enum Format {
+		  option features.enum_type = CLOSED;
+		  FORMAT_UNSPECIFIED = 0;
+		}
+		enum State {
+		  STATE_UNSPECIFIED = 0;
+		}
+	`)
+	for _, test := range []struct {
+		name string
+		enum *desc.EnumDescriptor
+		span []int32
+	}{
+		{"Present", f.GetEnumTypes()[0], []int32{2, 2, 37}},
+		{"Absent", f.GetEnumTypes()[1], nil},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			if diff := cmp.Diff(EnumType(test.enum).GetSpan(), test.span); diff != "" {
+				t.Errorf(diff)
+			}
+		})
+	}
+}
@@ -41,6 +41,19 @@ func FieldBehavior(f *desc.FieldDescriptor) *dpb.SourceCodeInfo_Location {
 	return pathLocation(f, 8, int(apb.E_FieldBehavior.TypeDescriptor().Number())) // FieldDescriptor.options == 8
 }
 
+// FieldBehaviorValue returns the precise location of the N-th value of a
+// field's field_behavior annotation.
+//
+// If the value has no location of its own, it returns the location of the
+// whole annotation.
+func FieldBehaviorValue(f *desc.FieldDescriptor, index int) *dpb.SourceCodeInfo_Location {
+	// FieldDescriptor.options == 8
+	if loc := pathLocation(f, 8, int(apb.E_FieldBehavior.TypeDescriptor().Number()), index); loc != nil {
+		return loc
+	}
+	return FieldBehavior(f)
+}
+
 // FieldType returns the precise location for a field's type.
 func FieldType(f *desc.FieldDescriptor) *dpb.SourceCodeInfo_Location {
 	if f.GetMessageType() != nil || f.GetEnumType() != nil {
@@ -53,3 +66,23 @@ func FieldType(f *desc.FieldDescriptor) *dpb.SourceCodeInfo_Location {
 func FieldLabel(f *desc.FieldDescriptor) *dpb.SourceCodeInfo_Location {
 	return pathLocation(f, 4) // FieldDescriptor.label == 4
 }
+
+// FieldPresence returns the precise location of a field's
+// `features.field_presence` option, for files using Protobuf Editions, or of
+// its label (such as proto3 `optional`) otherwise.
+func FieldPresence(f *desc.FieldDescriptor) *dpb.SourceCodeInfo_Location {
+	// FieldDescriptor.options == 8, FieldOptions.features == 21,
+	// FeatureSet.field_presence == 1
+	if loc := pathLocation(f, 8, 21, 1); loc != nil {
+		return loc
+	}
+	return FieldLabel(f)
+}
+
+// FieldMessageEncoding returns the precise location of a field's
+// `features.message_encoding` option.
+func FieldMessageEncoding(f *desc.FieldDescriptor) *dpb.SourceCodeInfo_Location {
+	// FieldDescriptor.options == 8, FieldOptions.features == 21,
+	// FeatureSet.message_encoding == 5
+	return pathLocation(f, 8, 21, 5)
+}
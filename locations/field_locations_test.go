@@ -72,6 +72,65 @@ func TestFieldLabel(t *testing.T) {
 	}
 }
 
+func TestFieldPresence(t *testing.T) {
+	proto3 := parse(t, `
+		message Book {
+			string name = 1;
+			optional string author = 2;
+		}
+	`)
+	editions := parse(t, `
+		edition = "2023";
+		message Book {
+		  string name = 1 [features.field_presence = IMPLICIT];
+		  string author = 2;
+		}
+	`)
+	for _, test := range []struct {
+		name  string
+		field *desc.FieldDescriptor
+		span  []int32
+	}{
+		{"Proto3Optional", proto3.GetMessageTypes()[0].GetFields()[1], []int32{4, 8, 16}},
+		{"Proto3Absent", proto3.GetMessageTypes()[0].GetFields()[0], nil},
+		{"EditionsFeature", editions.GetMessageTypes()[0].GetFields()[0], []int32{2, 19, 53}},
+		{"EditionsAbsent", editions.GetMessageTypes()[0].GetFields()[1], nil},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			l := FieldPresence(test.field)
+			if diff := cmp.Diff(l.GetSpan(), test.span); diff != "" {
+				t.Errorf(diff)
+			}
+		})
+	}
+}
+
+func TestFieldMessageEncoding(t *testing.T) {
+	f := parse(t, `
+		edition = "2023";
+		message Book {
+		  Author author = 1 [features.message_encoding = DELIMITED];
+		  Author editor = 2;
+		}
+		message Author {}
+	`)
+	for _, test := range []struct {
+		name  string
+		field *desc.FieldDescriptor
+		span  []int32
+	}{
+		{"Present", f.GetMessageTypes()[0].GetFields()[0], []int32{2, 21, 58}},
+		{"Absent", f.GetMessageTypes()[0].GetFields()[1], nil},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			l := FieldMessageEncoding(test.field)
+			if diff := cmp.Diff(l.GetSpan(), test.span); diff != "" {
+				t.Errorf(diff)
+			}
+		})
+	}
+}
+
 func TestFieldResourceReference(t *testing.T) {
 	f := parse(t, `
 		import "google/api/resource.proto";
@@ -102,3 +161,30 @@ func TestFieldOption(t *testing.T) {
 		t.Errorf(diff)
 	}
 }
+
+func TestFieldBehaviorValue(t *testing.T) {
+	f := parse(t, `
+		import "google/api/field_behavior.proto";
+		message Book {
+		  string name = 1 [
+		    (google.api.field_behavior) = IDENTIFIER,
+		    (google.api.field_behavior) = IMMUTABLE
+		  ];
+		}
+	`)
+	for _, test := range []struct {
+		name  string
+		index int
+		span  []int32
+	}{
+		{"First", 0, []int32{5, 4, 44}},
+		{"Second", 1, []int32{6, 4, 43}},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			loc := FieldBehaviorValue(f.GetMessageTypes()[0].GetFields()[0], test.index)
+			if diff := cmp.Diff(loc.GetSpan(), test.span); diff != "" {
+				t.Errorf(diff)
+			}
+		})
+	}
+}
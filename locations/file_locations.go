@@ -20,12 +20,16 @@ import (
 	dpb "google.golang.org/protobuf/types/descriptorpb"
 )
 
-// FileSyntax returns the location of the syntax definition in a file descriptor.
+// FileSyntax returns the location of the syntax definition in a file
+// descriptor, or of the edition definition for files using Protobuf Editions.
 //
 // If the location can not be found (for example, because there is no syntax
 // statement), it returns nil.
 func FileSyntax(f *desc.FileDescriptor) *dpb.SourceCodeInfo_Location {
-	return pathLocation(f, 12) // FileDescriptor.syntax == 12
+	if loc := pathLocation(f, 12); loc != nil { // FileDescriptor.syntax == 12
+		return loc
+	}
+	return pathLocation(f, 14) // FileDescriptor.edition == 14
 }
 
 // FilePackage returns the location of the package definition in a file descriptor.
@@ -72,6 +76,15 @@ func FileRubyPackage(f *desc.FileDescriptor) *dpb.SourceCodeInfo_Location {
 	return pathLocation(f, 8, 45) // 8 == options, 45 == ruby_package
 }
 
+// FileOption returns the location of the file option with the given field
+// number, e.g. 11 for go_package.
+//
+// If the location can not be found (for example, because the option is not
+// set), it returns nil.
+func FileOption(f *desc.FileDescriptor, fieldNumber int) *dpb.SourceCodeInfo_Location {
+	return pathLocation(f, 8, fieldNumber) // 8 == options
+}
+
 // FileResourceDefinition returns the precise location of the `google.api.resource_definition`
 // annotation.
 func FileResourceDefinition(f *desc.FileDescriptor, index int) *dpb.SourceCodeInfo_Location {
@@ -79,6 +92,21 @@ func FileResourceDefinition(f *desc.FileDescriptor, index int) *dpb.SourceCodeIn
 	return pathLocation(f, 8, int(apb.E_ResourceDefinition.TypeDescriptor().Number()), index)
 }
 
+// FileResourceDefinitionPattern returns the precise location of the N-th
+// `pattern` of the `google.api.resource_definition` annotation on the given
+// `index`.
+//
+// If the pattern has no location of its own (for example, because the file
+// was compiled without option locations), it returns the location of the
+// whole annotation.
+func FileResourceDefinitionPattern(f *desc.FileDescriptor, index, patternIndex int) *dpb.SourceCodeInfo_Location {
+	// 8 == options, 2 == ResourceDescriptor.pattern
+	if loc := pathLocation(f, 8, int(apb.E_ResourceDefinition.TypeDescriptor().Number()), index, 2, patternIndex); loc != nil {
+		return loc
+	}
+	return FileResourceDefinition(f, index)
+}
+
 // FileImport returns the location of the import on the given `index`, or `nil`
 // if no import with such `index` is found.
 func FileImport(f *desc.FileDescriptor, index int) *dpb.SourceCodeInfo_Location {
@@ -88,6 +88,12 @@ func TestLocations(t *testing.T) {
 				idx:      0,
 				wantSpan: []int32{3, 0, int32(len(`import "google/api/resource.proto";`))},
 			},
+			{
+				testName: "Option",
+				idxFx:    FileOption,
+				idx:      45, // ruby_package
+				wantSpan: []int32{10, 0, int32(len(`option ruby_package = "Google::Api::Linter";`))},
+			},
 			{
 				testName: "CCEnableArenas",
 				fx:       FileCCEnableArenas,
@@ -127,6 +133,46 @@ func TestLocations(t *testing.T) {
 	})
 }
 
+func TestFileResourceDefinitionPattern(t *testing.T) {
+	f := parse(t, `
+		import "google/api/resource.proto";
+		option (google.api.resource_definition) = {
+		  type: "library.googleapis.com/Publisher"
+		  pattern: "publishers/{publisher}"
+		};
+		option (google.api.resource_definition) = {
+		  type: "library.googleapis.com/Shelf"
+		  pattern: ["shelves/{shelf}", "projects/{project}/shelves/{shelf}"]
+		};
+	`)
+	for _, test := range []struct {
+		testName          string
+		index, patternIdx int
+		wantSpan          []int32
+	}{
+		{"First", 0, 0, []int32{5, 2, 35}},
+		{"List", 1, 1, []int32{9, 31, 67}},
+		{"Missing", 0, 1, []int32{3, 0, 6, 2}},
+	} {
+		t.Run(test.testName, func(t *testing.T) {
+			l := FileResourceDefinitionPattern(f, test.index, test.patternIdx)
+			if diff := cmp.Diff(l.GetSpan(), test.wantSpan); diff != "" {
+				t.Errorf(diff)
+			}
+		})
+	}
+}
+
+func TestFileSyntaxEdition(t *testing.T) {
+	f := parse(t, `
+		edition = "2023";
+		package google.api.linter;
+	`)
+	if diff := cmp.Diff(FileSyntax(f).GetSpan(), []int32{0, 0, int32(len(`edition = "2023";`))}); diff != "" {
+		t.Errorf(diff)
+	}
+}
+
 func TestMissingLocations(t *testing.T) {
 	m, err := builder.NewMessage("Foo").Build()
 	if err != nil {
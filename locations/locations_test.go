@@ -19,6 +19,7 @@ import (
 	"sync"
 	"testing"
 
+	"github.com/googleapis/api-linter/internal"
 	"github.com/jhump/protoreflect/desc"
 	"github.com/jhump/protoreflect/desc/protoparse"
 	"github.com/lithammer/dedent"
@@ -32,15 +33,14 @@ import (
 
 func parse(t *testing.T, s string) *desc.FileDescriptor {
 	s = strings.TrimSpace(dedent.Dedent(s))
-	if !strings.Contains(s, "syntax = ") {
+	if !strings.Contains(s, "syntax = ") && !strings.Contains(s, "edition = ") {
 		s = "syntax = \"proto3\";\n\n" + s
 	}
-	parser := protoparse.Parser{
+	parser := internal.Parser{
 		Accessor: protoparse.FileContentsFromMap(map[string]string{
 			"test.proto": strings.TrimSpace(dedent.Dedent(s)),
 		}),
-		IncludeSourceCodeInfo: true,
-		LookupImport:          desc.LoadFileDescriptor,
+		LookupImport: desc.LoadFileDescriptor,
 	}
 	fds, err := parser.ParseFiles("test.proto")
 	if err != nil {
@@ -25,3 +25,17 @@ import (
 func MessageResource(m *desc.MessageDescriptor) *dpb.SourceCodeInfo_Location {
 	return pathLocation(m, 7, int(apb.E_Resource.TypeDescriptor().Number())) // MessageDescriptor.options == 7
 }
+
+// MessageResourcePattern returns the precise location of the N-th `pattern`
+// of the `google.api.resource` annotation.
+//
+// If the pattern has no location of its own (for example, because the file
+// was compiled without option locations), it returns the location of the
+// whole annotation.
+func MessageResourcePattern(m *desc.MessageDescriptor, index int) *dpb.SourceCodeInfo_Location {
+	// MessageDescriptor.options == 7, ResourceDescriptor.pattern == 2
+	if loc := pathLocation(m, 7, int(apb.E_Resource.TypeDescriptor().Number()), 2, index); loc != nil {
+		return loc
+	}
+	return MessageResource(m)
+}
@@ -35,3 +35,32 @@ func TestMessageResource(t *testing.T) {
 		t.Errorf(diff)
 	}
 }
+
+func TestMessageResourcePattern(t *testing.T) {
+	f := parse(t, `
+		import "google/api/resource.proto";
+		message Book {
+		  option (google.api.resource) = {
+		    type: "library.googleapis.com/Book"
+		    pattern: "publishers/{publisher}/books/{book}"
+		    pattern: "books/{book}"
+		  };
+		}
+	`)
+	for _, test := range []struct {
+		name  string
+		index int
+		span  []int32
+	}{
+		{"First", 0, []int32{6, 4, 50}},
+		{"Second", 1, []int32{7, 4, 27}},
+		{"Missing", 2, []int32{4, 2, 8, 4}},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			loc := MessageResourcePattern(f.GetMessageTypes()[0], test.index)
+			if diff := cmp.Diff(loc.GetSpan(), test.span); diff != "" {
+				t.Errorf(diff)
+			}
+		})
+	}
+}
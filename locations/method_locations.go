@@ -18,6 +18,7 @@ import (
 	lrpb "cloud.google.com/go/longrunning/autogen/longrunningpb"
 	"github.com/jhump/protoreflect/desc"
 	apb "google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/protobuf/proto"
 	dpb "google.golang.org/protobuf/types/descriptorpb"
 )
 
@@ -37,6 +38,94 @@ func MethodHTTPRule(m *desc.MethodDescriptor) *dpb.SourceCodeInfo_Location {
 	return MethodOption(m, int(apb.E_Http.TypeDescriptor().Number()))
 }
 
+// MethodHTTPRuleBinding returns the precise location of a single binding of
+// the method's `google.api.http` rule. Index 0 is the rule itself, and index
+// i > 0 is the (i-1)-th entry of `additional_bindings`, matching the order of
+// utils.GetHTTPRules.
+//
+// If the binding has no location of its own (for example, because the file
+// was compiled without option locations), it returns the location of the
+// whole `google.api.http` rule.
+func MethodHTTPRuleBinding(m *desc.MethodDescriptor, index int) *dpb.SourceCodeInfo_Location {
+	if index > 0 {
+		if loc := MethodHTTPAdditionalBinding(m, index-1); loc != nil {
+			return loc
+		}
+	}
+	return MethodHTTPRule(m)
+}
+
+// MethodHTTPAdditionalBinding returns the precise location of the N-th entry
+// of the `additional_bindings` of the method's `google.api.http` rule, if any.
+func MethodHTTPAdditionalBinding(m *desc.MethodDescriptor, index int) *dpb.SourceCodeInfo_Location {
+	return httpRuleLocation(m, 11, index) // HttpRule.additional_bindings == 11
+}
+
+// MethodHTTPRuleURI returns the precise location of the URI template string
+// (e.g. the value of `get`) of a single binding of the method's
+// `google.api.http` rule. The index is interpreted as in MethodHTTPRuleBinding.
+//
+// If the URI template has no location of its own, it falls back to the
+// location of the binding.
+func MethodHTTPRuleURI(m *desc.MethodDescriptor, index int) *dpb.SourceCodeInfo_Location {
+	rule, path := httpRuleBinding(m, index)
+	var uri []int
+	switch rule.GetPattern().(type) {
+	case *apb.HttpRule_Get:
+		uri = []int{2} // HttpRule.get == 2
+	case *apb.HttpRule_Put:
+		uri = []int{3} // HttpRule.put == 3
+	case *apb.HttpRule_Post:
+		uri = []int{4} // HttpRule.post == 4
+	case *apb.HttpRule_Delete:
+		uri = []int{5} // HttpRule.delete == 5
+	case *apb.HttpRule_Patch:
+		uri = []int{6} // HttpRule.patch == 6
+	case *apb.HttpRule_Custom:
+		uri = []int{8, 2} // HttpRule.custom == 8, CustomHttpPattern.path == 2
+	}
+	if uri != nil {
+		if loc := httpRuleLocation(m, append(path, uri...)...); loc != nil {
+			return loc
+		}
+	}
+	return MethodHTTPRuleBinding(m, index)
+}
+
+// MethodHTTPRuleBody returns the precise location of the `body` string of a
+// single binding of the method's `google.api.http` rule. The index is
+// interpreted as in MethodHTTPRuleBinding.
+//
+// If the body has no location of its own (including when it is not set), it
+// falls back to the location of the binding.
+func MethodHTTPRuleBody(m *desc.MethodDescriptor, index int) *dpb.SourceCodeInfo_Location {
+	if rule, path := httpRuleBinding(m, index); rule != nil {
+		if loc := httpRuleLocation(m, append(path, 7)...); loc != nil { // HttpRule.body == 7
+			return loc
+		}
+	}
+	return MethodHTTPRuleBinding(m, index)
+}
+
+// httpRuleBinding returns the given binding of the method's `google.api.http`
+// rule, along with its path relative to the rule.
+func httpRuleBinding(m *desc.MethodDescriptor, index int) (*apb.HttpRule, []int) {
+	rule, _ := proto.GetExtension(m.GetMethodOptions(), apb.E_Http).(*apb.HttpRule)
+	if index == 0 {
+		return rule, nil
+	}
+	if bindings := rule.GetAdditionalBindings(); index > 0 && index <= len(bindings) {
+		return bindings[index-1], []int{11, index - 1} // HttpRule.additional_bindings == 11
+	}
+	return nil, nil
+}
+
+// httpRuleLocation returns the location of the given path within the method's
+// `google.api.http` rule.
+func httpRuleLocation(m *desc.MethodDescriptor, path ...int) *dpb.SourceCodeInfo_Location {
+	return pathLocation(m, append([]int{4, int(apb.E_Http.TypeDescriptor().Number())}, path...)...) // MethodDescriptor.options == 4
+}
+
 // MethodOperationInfo returns the precise location of the method's
 // `google.longrunning.operation_info` annotation, if any.
 func MethodOperationInfo(m *desc.MethodDescriptor) *dpb.SourceCodeInfo_Location {
@@ -49,6 +138,25 @@ func MethodSignature(m *desc.MethodDescriptor, index int) *dpb.SourceCodeInfo_Lo
 	return pathLocation(m, 4, int(apb.E_MethodSignature.TypeDescriptor().Number()), index) // MethodDescriptor.options == 4
 }
 
+// MethodRouting returns the precise location of the method's
+// `google.api.routing` annotation, if any.
+func MethodRouting(m *desc.MethodDescriptor) *dpb.SourceCodeInfo_Location {
+	return MethodOption(m, int(apb.E_Routing.TypeDescriptor().Number()))
+}
+
+// MethodRoutingParameter returns the precise location of the N-th entry of
+// the `routing_parameters` of the method's `google.api.routing` annotation.
+//
+// If the entry has no location of its own, it returns the location of the
+// whole annotation.
+func MethodRoutingParameter(m *desc.MethodDescriptor, index int) *dpb.SourceCodeInfo_Location {
+	// RoutingRule.routing_parameters == 2
+	if loc := pathLocation(m, 4, int(apb.E_Routing.TypeDescriptor().Number()), 2, index); loc != nil { // MethodDescriptor.options == 4
+		return loc
+	}
+	return MethodRouting(m)
+}
+
 // MethodOption returns the precise location of the method's option with the given field number, if any.
 func MethodOption(m *desc.MethodDescriptor, fieldNumber int) *dpb.SourceCodeInfo_Location {
 	return pathLocation(m, 4, fieldNumber) // MethodDescriptor.options == 4
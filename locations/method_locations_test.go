@@ -18,6 +18,7 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	dpb "google.golang.org/protobuf/types/descriptorpb"
 )
 
 func TestMethodRequestType(t *testing.T) {
@@ -148,3 +149,75 @@ func TestMethodOption(t *testing.T) {
 		})
 	}
 }
+
+func TestMethodHTTPRuleParts(t *testing.T) {
+	f := parse(t, `
+		import "google/api/annotations.proto";
+		service Library {
+		  rpc UpdateBook(UpdateBookRequest) returns (Book) {
+		    option (google.api.http) = {
+		      patch: "/v1/{book.name=publishers/*/books/*}"
+		      body: "book"
+		      additional_bindings { post: "/v1/{book.name=publishers/*/books/*}:update" body: "*" }
+		      additional_bindings { custom { kind: "HEAD" path: "/v1/{book.name=books/*}" } }
+		    };
+		  }
+		}
+		message UpdateBookRequest {}
+		message Book {}
+	`)
+	m := f.GetServices()[0].GetMethods()[0]
+	for _, test := range []struct {
+		name string
+		loc  *dpb.SourceCodeInfo_Location
+		span []int32
+	}{
+		{"URI", MethodHTTPRuleURI(m, 0), []int32{6, 6, 51}},
+		{"Body", MethodHTTPRuleBody(m, 0), []int32{7, 6, 18}},
+		{"Binding", MethodHTTPRuleBinding(m, 0), []int32{5, 4, 10, 6}},
+		{"AdditionalBinding", MethodHTTPAdditionalBinding(m, 0), []int32{8, 6, 91}},
+		{"AdditionalBindingURI", MethodHTTPRuleURI(m, 1), []int32{8, 28, 79}},
+		{"AdditionalBindingBody", MethodHTTPRuleBody(m, 1), []int32{8, 80, 89}},
+		{"CustomURI", MethodHTTPRuleURI(m, 2), []int32{9, 50, 81}},
+		{"MissingBody", MethodHTTPRuleBody(m, 2), []int32{9, 6, 85}},
+		{"MissingBinding", MethodHTTPRuleURI(m, 3), []int32{5, 4, 10, 6}},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			if diff := cmp.Diff(test.loc.GetSpan(), test.span); diff != "" {
+				t.Errorf(diff)
+			}
+		})
+	}
+}
+
+func TestMethodRouting(t *testing.T) {
+	f := parse(t, `
+		import "google/api/routing.proto";
+		service Library {
+		  rpc GetBook(GetBookRequest) returns (Book) {
+		    option (google.api.routing) = {
+		      routing_parameters { field: "name" }
+		      routing_parameters { field: "name" path_template: "{shelf=shelves/*}/**" }
+		    };
+		  }
+		}
+		message GetBookRequest {}
+		message Book {}
+	`)
+	m := f.GetServices()[0].GetMethods()[0]
+	for _, test := range []struct {
+		name string
+		loc  *dpb.SourceCodeInfo_Location
+		span []int32
+	}{
+		{"Routing", MethodRouting(m), []int32{5, 4, 8, 6}},
+		{"Parameter", MethodRoutingParameter(m, 1), []int32{7, 6, 80}},
+		{"MissingParameter", MethodRoutingParameter(m, 2), []int32{5, 4, 8, 6}},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			if diff := cmp.Diff(test.loc.GetSpan(), test.span); diff != "" {
+				t.Errorf(diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,52 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package locations
+
+import (
+	"google.golang.org/protobuf/reflect/protoreflect"
+	dpb "google.golang.org/protobuf/types/descriptorpb"
+)
+
+// ReflectDescriptorName returns the precise location for a descriptor's name,
+// for rules using the descriptors of google.golang.org/protobuf.
+//
+// This works for any descriptor but a file, regardless of type (message,
+// field, etc.).
+func ReflectDescriptorName(d protoreflect.Descriptor) *dpb.SourceCodeInfo_Location {
+	return reflectPathLocation(d, 1)
+}
+
+// reflectPathLocation is pathLocation for the descriptors of
+// google.golang.org/protobuf, which hold their own source locations.
+func reflectPathLocation(d protoreflect.Descriptor, path ...int32) *dpb.SourceCodeInfo_Location {
+	if _, ok := d.(protoreflect.FileDescriptor); ok {
+		return nil
+	}
+	locs := d.ParentFile().SourceLocations()
+	base := locs.ByDescriptor(d).Path
+	if len(base) == 0 {
+		return nil
+	}
+	fullPath := append(append(protoreflect.SourcePath{}, base...), path...)
+	loc := locs.ByPath(fullPath)
+	if len(loc.Path) == 0 {
+		return nil
+	}
+	span := []int32{int32(loc.StartLine), int32(loc.StartColumn), int32(loc.EndLine), int32(loc.EndColumn)}
+	if loc.StartLine == loc.EndLine {
+		span = []int32{int32(loc.StartLine), int32(loc.StartColumn), int32(loc.EndColumn)}
+	}
+	return &dpb.SourceCodeInfo_Location{Path: fullPath, Span: span}
+}
@@ -0,0 +1,52 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package locations
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func TestReflectDescriptorName(t *testing.T) {
+	f := parse(t, `
+		message Foo {
+		  string bar = 1;
+		  map<string, string> baz = 2;
+		}
+	`).UnwrapFile()
+	m := f.Messages().Get(0)
+
+	tests := []struct {
+		testName string
+		d        protoreflect.Descriptor
+		wantSpan []int32
+	}{
+		{"Message", m, []int32{2, 8, 11}},
+		{"Field", m.Fields().Get(0), []int32{3, 9, 12}},
+		{"MapField", m.Fields().Get(1), []int32{4, 22, 25}},
+	}
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			if diff := cmp.Diff(ReflectDescriptorName(test.d).GetSpan(), test.wantSpan); diff != "" {
+				t.Errorf(diff)
+			}
+		})
+	}
+	if got := ReflectDescriptorName(f); got != nil {
+		t.Errorf("ReflectDescriptorName(file) = %v, want nil", got)
+	}
+}
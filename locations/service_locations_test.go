@@ -0,0 +1,48 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package locations
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	apb "google.golang.org/genproto/googleapis/api/annotations"
+)
+
+func TestServiceOption(t *testing.T) {
+	f := parse(t, `
+		import "google/api/client.proto";
+		service Library {
+		  option (google.api.default_host) = "library.googleapis.com";
+		}
+		service Shelves {}
+	`)
+
+	for _, test := range []struct {
+		name       string
+		serviceIdx int
+		want       []int32
+	}{
+		{"OptionSet", 0, []int32{4, 2, 62}},
+		{"OptionNotSet", 1, nil},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			loc := ServiceOption(f.GetServices()[test.serviceIdx], int(apb.E_DefaultHost.TypeDescriptor().Number()))
+			if diff := cmp.Diff(loc.GetSpan(), test.want); diff != "" {
+				t.Errorf("Diff: %s", diff)
+			}
+		})
+	}
+}
@@ -15,6 +15,7 @@
 package aip0121
 
 import (
+	"fmt"
 	"strings"
 
 	"bitbucket.org/creachadair/stringset"
@@ -24,64 +25,112 @@ import (
 	"github.com/jhump/protoreflect/desc"
 )
 
-var noMutableCycles = &lint.MessageRule{
-	Name:   lint.NewRuleName(121, "no-mutable-cycles"),
-	OnlyIf: utils.IsResource,
-	LintMessage: func(m *desc.MessageDescriptor) []lint.Problem {
-		res := utils.GetResource(m)
+var noMutableCycles = lint.NewFileSetRule(func(set *lint.FileSet) lint.ProtoRule {
+	return &lint.MessageRule{
+		Name:   lint.NewRuleName(121, "no-mutable-cycles"),
+		OnlyIf: utils.IsResource,
+		LintMessage: func(m *desc.MessageDescriptor) []lint.Problem {
+			start := utils.GetResource(m).GetType()
 
-		return findCycles(res.GetType(), m, stringset.New(), nil)
-	},
-}
+			var problems []lint.Problem
+			for _, f := range m.GetFields() {
+				if !isMutableReference(f) {
+					continue
+				}
+				// Report at most one cycle per field.
+				for _, target := range referencedTypes(f, set) {
+					rest := findCycle(start, target, f.GetFile(), set, stringset.New(start))
+					if rest == nil {
+						continue
+					}
+					problems = append(problems, cycleProblem(start, append([]hop{{f, target}}, rest...)))
+					break
+				}
+			}
+			return problems
+		},
+	}
+})
 
-func findCycles(start string, node *desc.MessageDescriptor, seen stringset.Set, chain []string) []lint.Problem {
-	var problems []lint.Problem
-	nodeRes := utils.GetResource(node)
+// hop is a single mutable reference in a reference cycle.
+type hop struct {
+	field  *desc.FieldDescriptor
+	target string
+}
 
-	chain = append(chain, nodeRes.GetType())
-	seen.Add(nodeRes.GetType())
+// findCycle searches for a chain of mutable references leading from the
+// current resource type back to the start. It returns the hops of the chain
+// (an empty, non-nil slice when current is the start), or nil if there is no
+// such chain.
+func findCycle(start, current string, file *desc.FileDescriptor, set *lint.FileSet, seen stringset.Set) []hop {
+	if current == start {
+		return []hop{}
+	}
+	if seen.Contains(current) {
+		return nil
+	}
+	seen.Add(current)
 
+	node := utils.FindResourceMessage(current, file, set)
+	// Skip unresolvable references.
+	if node == nil {
+		return nil
+	}
 	for _, f := range node.GetFields() {
 		if !isMutableReference(f) {
 			continue
 		}
-		ref := utils.GetResourceReference(f)
-		// Skip indirect references for now.
-		if ref.GetChildType() != "" {
-			continue
-		}
-		if ref.GetType() == start {
-			cycle := strings.Join(append(chain, start), " > ")
-			problems = append(problems, lint.Problem{
-				Message:    "mutable resource reference introduces a reference cycle:\n" + cycle,
-				Descriptor: f,
-				Location:   locations.FieldResourceReference(f),
-			})
-		} else if !seen.Contains(ref.GetType()) {
-			next := utils.FindResourceMessage(ref.GetType(), node.GetFile())
-			// Skip unresolvable references.
-			if next == nil {
-				continue
-			}
-			if probs := findCycles(start, next, seen.Clone(), chain); len(probs) == 1 {
-				// A recursive call will only have one finding as it returns
-				// immediately.
-				p := probs[0]
-				p.Descriptor = f
-				p.Location = locations.FieldResourceReference(f)
-
-				problems = append(problems, p)
+		for _, target := range referencedTypes(f, set) {
+			if rest := findCycle(start, target, node.GetFile(), set, seen); rest != nil {
+				return append([]hop{{f, target}}, rest...)
 			}
 		}
-		// Recursive calls should return immediately upon finding a cycle.
-		// The initial scan of the starting resource message should scan
-		// all of its own fields and not return immediately.
-		if len(problems) > 0 && len(chain) > 1 {
-			return problems
-		}
 	}
+	return nil
+}
+
+// referencedTypes returns every resource type the field may reference.
+//
+// A `child_type` reference refers to any resource that can be the parent of
+// the given child type, so it is expanded to each of those.
+func referencedTypes(f *desc.FieldDescriptor, set *lint.FileSet) []string {
+	ref := utils.GetResourceReference(f)
+	if ref.GetChildType() == "" {
+		return []string{ref.GetType()}
+	}
+	child := utils.FindResource(ref.GetChildType(), f.GetFile(), set)
+	if child == nil {
+		return nil
+	}
+	var types []string
+	for _, p := range utils.FindResourceParents(child, f.GetFile(), set) {
+		types = append(types, p.GetType())
+	}
+	return types
+}
 
-	return problems
+// cycleProblem reports the cycle on its first hop, and attaches every other
+// hop as a related location.
+func cycleProblem(start string, chain []hop) lint.Problem {
+	types := []string{start}
+	for _, h := range chain {
+		types = append(types, h.target)
+	}
+	var related []lint.RelatedLocation
+	for _, h := range chain[1:] {
+		related = append(related, lint.RelatedLocation{
+			Message:    fmt.Sprintf("mutable reference to %q", h.target),
+			Descriptor: h.field,
+			Location:   locations.FieldResourceReference(h.field),
+		})
+	}
+	first := chain[0].field
+	return lint.Problem{
+		Message:          "mutable resource reference introduces a reference cycle:\n" + strings.Join(types, " > "),
+		Descriptor:       first,
+		Location:         locations.FieldResourceReference(first),
+		RelatedLocations: related,
+	}
 }
 
 func isMutableReference(f *desc.FieldDescriptor) bool {
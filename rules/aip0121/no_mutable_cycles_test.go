@@ -17,7 +17,10 @@ package aip0121
 import (
 	"testing"
 
+	"github.com/googleapis/api-linter/lint"
+	"github.com/googleapis/api-linter/locations"
 	"github.com/googleapis/api-linter/rules/internal/testutils"
+	"github.com/jhump/protoreflect/desc"
 )
 
 func TestNoMutableCycles(t *testing.T) {
@@ -80,6 +83,24 @@ func TestNoMutableCycles(t *testing.T) {
 				},
 			},
 		},
+		{
+			"InvalidChildTypeCycle",
+			`[(google.api.resource_reference).child_type = "library.googleapis.com/Book"]`,
+			`[(google.api.resource_reference).type = "library.googleapis.com/Book"]`,
+			"",
+			"",
+			testutils.Problems{{
+				Message: "library.googleapis.com/Publisher > library.googleapis.com/Book > library.googleapis.com/Publisher",
+			}},
+		},
+		{
+			"ValidChildTypeNoCycle",
+			`[(google.api.resource_reference).child_type = "library.googleapis.com/Publisher"]`,
+			`[(google.api.resource_reference).type = "library.googleapis.com/Book"]`,
+			"",
+			"",
+			nil,
+		},
 		{
 			"ValidOutputOnlyCyclicReference",
 			`[(google.api.resource_reference).type = "library.googleapis.com/Publisher"]`,
@@ -151,9 +172,65 @@ func TestNoMutableCycles(t *testing.T) {
 			// If this rule was run on the entire test file, there would be two
 			// findings, one for each resource in the cycle. To simplify that,
 			// we just lint one of the offending messages.
-			if diff := want.Diff(noMutableCycles.LintMessage(msg)); diff != "" {
+			if diff := want.Diff(noMutableCycles.WithFileSet(nil).(*lint.MessageRule).LintMessage(msg)); diff != "" {
 				t.Error(diff)
 			}
 		})
 	}
 }
+
+func TestNoMutableCycles_RelatedLocations(t *testing.T) {
+	f := testutils.ParseProto3String(t, `
+		import "google/api/resource.proto";
+		message Book {
+			option (google.api.resource) = {
+				type: "library.googleapis.com/Book"
+				pattern: "publishers/{publisher}/books/{book}"
+			};
+			string name = 1;
+			string publisher = 2 [(google.api.resource_reference).type = "library.googleapis.com/Publisher"];
+		}
+
+		message Publisher {
+			option (google.api.resource) = {
+				type: "library.googleapis.com/Publisher"
+				pattern: "publishers/{publisher}"
+			};
+			string name = 1;
+			string library = 2 [(google.api.resource_reference).type = "library.googleapis.com/Library"];
+		}
+
+		message Library {
+			option (google.api.resource) = {
+				type: "library.googleapis.com/Library"
+				pattern: "libraries/{library}"
+			};
+			string name = 1;
+			string book = 2 [(google.api.resource_reference).type = "library.googleapis.com/Book"];
+		}
+	`)
+	problems := noMutableCycles.WithFileSet(nil).(*lint.MessageRule).LintMessage(f.FindMessage("Publisher"))
+	if len(problems) != 1 {
+		t.Fatalf("Expected one problem, got %d", len(problems))
+	}
+	want := "library.googleapis.com/Publisher > library.googleapis.com/Library > library.googleapis.com/Book > library.googleapis.com/Publisher"
+	if diff := (testutils.Problems{{Message: want, Descriptor: f.FindMessage("Publisher").FindFieldByName("library")}}).Diff(problems); diff != "" {
+		t.Error(diff)
+	}
+	related := problems[0].RelatedLocations
+	hops := []*desc.FieldDescriptor{
+		f.FindMessage("Library").FindFieldByName("book"),
+		f.FindMessage("Book").FindFieldByName("publisher"),
+	}
+	if len(related) != len(hops) {
+		t.Fatalf("Expected %d related locations, got %d", len(hops), len(related))
+	}
+	for i, hop := range hops {
+		if related[i].Descriptor != hop {
+			t.Errorf("Related location %d: got %v, want %v", i, related[i].Descriptor, hop)
+		}
+		if related[i].Location != locations.FieldResourceReference(hop) {
+			t.Errorf("Related location %d: got location %v, want the resource reference of %s", i, related[i].Location, hop.GetName())
+		}
+	}
+}
@@ -29,6 +29,7 @@ func AddRules(r lint.RuleRegistry) error {
 		nameSuffix,
 		noSelfLinks,
 		resourceReferenceType,
+		resourceReferenceResolvable,
 		resourceIdOutputOnly,
 		embeddedResource,
 	)
@@ -28,12 +28,12 @@ var httpURICase = &lint.MethodRule{
 	Name: lint.NewRuleName(122, "camel-case-uris"),
 	LintMethod: func(m *desc.MethodDescriptor) (problems []lint.Problem) {
 		// Establish that the URI does not include a `_` character.
-		for _, httpRule := range utils.GetHTTPRules(m) {
+		for i, httpRule := range utils.GetHTTPRules(m) {
 			if strings.Contains(httpRule.GetPlainURI(), "_") {
 				problems = append(problems, lint.Problem{
 					Message:    "HTTP URI patterns should use camel case, not snake case.",
 					Descriptor: m,
-					Location:   locations.MethodHTTPRule(m),
+					Location:   locations.MethodHTTPRuleURI(m, i),
 				})
 			}
 			for v := range httpRule.GetVariables() {
@@ -41,16 +41,10 @@ var httpURICase = &lint.MethodRule{
 					problems = append(problems, lint.Problem{
 						Message:    "Variable names in URI patterns should use snake case, not camel case.",
 						Descriptor: m,
-						Location:   locations.MethodHTTPRule(m),
+						Location:   locations.MethodHTTPRuleURI(m, i),
 					})
 				}
 			}
-
-			// FIXME: We intentionally only return at most one of each type of `Problem` here.
-			// When we can attach problems to the particular annotation, remove this.
-			if len(problems) > 0 {
-				return
-			}
 		}
 		return
 	},
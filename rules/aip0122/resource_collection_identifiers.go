@@ -35,12 +35,12 @@ var resourceCollectionIdentifiers = &lint.MessageRule{
 	LintMessage: func(m *desc.MessageDescriptor) []lint.Problem {
 		var problems []lint.Problem
 		resource := utils.GetResource(m)
-		for _, p := range resource.GetPattern() {
+		for i, p := range resource.GetPattern() {
 			if !firstCharRegexp.MatchString(p) {
 				return append(problems, lint.Problem{
 					Message:    "Resource patterns must start with a lowercase letter.",
 					Descriptor: m,
-					Location:   locations.MessageResource(m),
+					Location:   locations.MessageResourcePattern(m, i),
 				})
 			}
 
@@ -53,7 +53,7 @@ var resourceCollectionIdentifiers = &lint.MessageRule{
 					problems = append(problems, lint.Problem{
 						Message:    "Resource patterns must use lowerCamelCase for collection identifiers.",
 						Descriptor: m,
-						Location:   locations.MessageResource(m),
+						Location:   locations.MessageResourcePattern(m, i),
 					})
 				}
 			}
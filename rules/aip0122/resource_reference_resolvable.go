@@ -0,0 +1,50 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aip0122
+
+import (
+	"fmt"
+
+	"github.com/googleapis/api-linter/lint"
+	"github.com/googleapis/api-linter/locations"
+	"github.com/googleapis/api-linter/rules/internal/utils"
+	"github.com/jhump/protoreflect/desc"
+)
+
+var resourceReferenceResolvable = lint.NewFileSetRule(func(set *lint.FileSet) lint.ProtoRule {
+	return &lint.FieldRule{
+		Name:   lint.NewRuleName(122, "resource-reference-resolvable"),
+		OnlyIf: utils.HasResourceReference,
+		LintField: func(f *desc.FieldDescriptor) []lint.Problem {
+			ref := utils.GetResourceReference(f)
+			typ := ref.GetType()
+			if typ == "" {
+				typ = ref.GetChildType()
+			}
+			// The wildcard type references any resource.
+			if typ == "" || typ == "*" {
+				return nil
+			}
+			if utils.FindResource(typ, f.GetFile(), set) == nil {
+				return []lint.Problem{{
+					Message:    fmt.Sprintf("Resource reference %q does not resolve to any resource in the linted files, descriptor sets, or imports.", typ),
+					Descriptor: f,
+					Location:   locations.FieldResourceReference(f),
+				}}
+			}
+			return nil
+		},
+	}
+})
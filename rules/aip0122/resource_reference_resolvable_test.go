@@ -0,0 +1,119 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aip0122
+
+import (
+	"testing"
+
+	"github.com/googleapis/api-linter/lint"
+	"github.com/googleapis/api-linter/rules/internal/testutils"
+	"github.com/jhump/protoreflect/desc"
+)
+
+func TestResourceReferenceResolvable(t *testing.T) {
+	for _, test := range []struct {
+		name     string
+		Ref      string
+		problems testutils.Problems
+	}{
+		{"ValidMessage", `type: "library.googleapis.com/Author"`, nil},
+		{"ValidDefinition", `type: "library.googleapis.com/Publisher"`, nil},
+		{"ValidChildType", `child_type: "library.googleapis.com/Author"`, nil},
+		{"ValidWildcard", `type: "*"`, nil},
+		{"InvalidType", `type: "library.googleapis.com/Editor"`, testutils.Problems{{Message: "library.googleapis.com/Editor"}}},
+		{"InvalidChildType", `child_type: "library.googleapis.com/Editor"`, testutils.Problems{{Message: "does not resolve"}}},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			f := testutils.ParseProto3Tmpl(t, `
+				import "google/api/resource.proto";
+
+				option (google.api.resource_definition) = {
+					type: "library.googleapis.com/Publisher"
+					pattern: "publishers/{publisher}"
+				};
+
+				message Book {
+					string author = 1 [(google.api.resource_reference) = { {{.Ref}} }];
+				}
+
+				message Author {
+					option (google.api.resource) = {
+						type: "library.googleapis.com/Author"
+						pattern: "authors/{author}"
+					};
+					string name = 1;
+				}
+			`, test)
+			field := f.GetMessageTypes()[0].GetFields()[0]
+			if diff := test.problems.SetDescriptor(field).Diff(resourceReferenceResolvable.Lint(f)); diff != "" {
+				t.Errorf(diff)
+			}
+		})
+	}
+}
+
+func TestResourceReferenceResolvable_LintRun(t *testing.T) {
+	// The files do not import one another, so the reference only resolves
+	// when both are part of the same lint run.
+	files := testutils.ParseProtoStrings(t, map[string]string{
+		"book.proto": `
+			syntax = "proto3";
+			import "google/api/resource.proto";
+			message Book {
+				string author = 1 [(google.api.resource_reference).type = "library.googleapis.com/Author"];
+			}
+		`,
+		"author.proto": `
+			syntax = "proto3";
+			import "google/api/resource.proto";
+			message Author {
+				option (google.api.resource) = {
+					type: "library.googleapis.com/Author"
+					pattern: "authors/{author}"
+				};
+				string name = 1;
+			}
+		`,
+	})
+	book := files["book.proto"]
+	if got := resourceReferenceResolvable.Lint(book); len(got) != 1 {
+		t.Errorf("Expected one problem when linting book.proto alone, got %v", got)
+	}
+
+	registry := lint.NewRuleRegistry()
+	if err := registry.Register(122, resourceReferenceResolvable); err != nil {
+		t.Fatal(err)
+	}
+	for name, opts := range map[string][]lint.LinterOption{
+		"LintedFiles": nil,
+		"LookupFiles": {lint.LookupFiles(files["author.proto"])},
+	} {
+		t.Run(name, func(t *testing.T) {
+			linted := []*desc.FileDescriptor{book}
+			if opts == nil {
+				linted = append(linted, files["author.proto"])
+			}
+			resps, err := lint.New(registry, nil, opts...).LintProtos(linted...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, resp := range resps {
+				if len(resp.Problems) != 0 {
+					t.Errorf("Expected no problems in %s, got %v", resp.FilePath, resp.Problems)
+				}
+			}
+		})
+	}
+}
@@ -123,11 +123,25 @@ var duplicateResource = &lint.FileRule{
 			}
 			sort.Strings(locs)
 			msg := fmt.Sprintf("Multiple definitions for resource %q: %s.", t, strings.Join(locs, ", "))
-			for _, d := range ds {
+			all := append(append([]resourceDef{}, ds...), defsInDeps[t]...)
+			for i, d := range ds {
+				// Point at every other definition of the resource.
+				var related []lint.RelatedLocation
+				for j, other := range all {
+					if j == i {
+						continue
+					}
+					related = append(related, lint.RelatedLocation{
+						Message:    fmt.Sprintf("Resource %q is also defined by %s.", t, other.String()),
+						Descriptor: other.desc,
+						Location:   other.location(),
+					})
+				}
 				ps = append(ps, lint.Problem{
-					Message:    msg,
-					Descriptor: d.desc,
-					Location:   d.location(),
+					Message:          msg,
+					Descriptor:       d.desc,
+					Location:         d.location(),
+					RelatedLocations: related,
 				})
 			}
 		}
@@ -18,6 +18,7 @@ import (
 	"testing"
 
 	"github.com/googleapis/api-linter/rules/internal/testutils"
+	"github.com/jhump/protoreflect/desc"
 )
 
 func TestDuplicateResource(t *testing.T) {
@@ -70,7 +71,27 @@ func TestDuplicateResource(t *testing.T) {
 			Descriptor: f,
 		},
 	}
-	if diff := want.Diff(duplicateResource.Lint(f)); diff != "" {
+	got := duplicateResource.Lint(f)
+	if diff := want.Diff(got); diff != "" {
 		t.Fatal(diff)
 	}
+
+	// Each problem points at the other definitions of the resource.
+	for i, wantRelated := range [][]desc.Descriptor{
+		{f.GetMessageTypes()[1]},
+		{f},
+		{f.GetMessageTypes()[2].GetNestedMessageTypes()[0]},
+		{f.GetMessageTypes()[0]},
+		{f.GetDependencies()[0].GetMessageTypes()[0]},
+	} {
+		related := got[i].RelatedLocations
+		if len(related) != len(wantRelated) {
+			t.Fatalf("Problem %d: got %d related locations, want %d", i, len(related), len(wantRelated))
+		}
+		for j, d := range wantRelated {
+			if related[j].Descriptor != d {
+				t.Errorf("Problem %d: got related descriptor %v, want %v", i, related[j].Descriptor, d)
+			}
+		}
+	}
 }
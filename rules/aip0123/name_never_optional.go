@@ -37,11 +37,15 @@ var nameNeverOptional = &lint.MessageRule{
 		}
 		field := m.FindFieldByName(f)
 
-		if field.IsProto3Optional() {
+		if utils.HasExplicitPresence(field) {
+			msg := "Resource name fields must never be labeled with proto3_optional"
+			if utils.IsEditions(field.GetFile()) {
+				msg = "Resource name fields must use `features.field_presence = IMPLICIT`"
+			}
 			return []lint.Problem{{
-				Message:    "Resource name fields must never be labeled with proto3_optional",
+				Message:    msg,
 				Descriptor: field,
-				Location:   locations.FieldLabel(field),
+				Location:   locations.FieldPresence(field),
 				Suggestion: "",
 			}}
 		}
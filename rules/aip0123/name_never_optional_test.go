@@ -53,3 +53,34 @@ func TestNameNeverOptional(t *testing.T) {
 		})
 	}
 }
+
+func TestNameNeverOptionalEditions(t *testing.T) {
+	for _, test := range []struct {
+		name     string
+		Options  string
+		problems testutils.Problems
+	}{
+		{"ValidImplicit", "[features.field_presence = IMPLICIT]", testutils.Problems{}},
+		{"InvalidExplicitByDefault", "", testutils.Problems{{Message: "features.field_presence = IMPLICIT"}}},
+		{"InvalidExplicit", "[features.field_presence = EXPLICIT]", testutils.Problems{{Message: "features.field_presence = IMPLICIT"}}},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			f := testutils.ParseProtoStrings(t, map[string]string{"test.proto": `
+				edition = "2023";
+				import "google/api/resource.proto";
+				message Book {
+					option (google.api.resource) = {
+						type: "library.googleapis.com/Book"
+						pattern: "publishers/{publisher}/books/{book}"
+					};
+
+					string name = 1 ` + test.Options + `;
+				}
+			`})["test.proto"]
+			field := f.GetMessageTypes()[0].GetFields()[0]
+			if diff := test.problems.SetDescriptor(field).Diff(nameNeverOptional.Lint(f)); diff != "" {
+				t.Errorf(diff)
+			}
+		})
+	}
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/googleapis/api-linter/locations"
 	"github.com/googleapis/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
+	dpb "google.golang.org/protobuf/types/descriptorpb"
 )
 
 var resourceDefinitionPatterns = &lint.FileRule{
@@ -29,7 +30,9 @@ var resourceDefinitionPatterns = &lint.FileRule{
 		resources := utils.GetResourceDefinitions(f)
 
 		for ndx, resource := range resources {
-			loc := locations.FileResourceDefinition(f, ndx)
+			loc := func(i int) *dpb.SourceCodeInfo_Location {
+				return locations.FileResourceDefinitionPattern(f, ndx, i)
+			}
 			probs := lintResourcePattern(resource, f, loc)
 			problems = append(problems, probs...)
 		}
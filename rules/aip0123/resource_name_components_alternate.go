@@ -33,7 +33,7 @@ var resourceNameComponentsAlternate = &lint.MessageRule{
 	LintMessage: func(m *desc.MessageDescriptor) []lint.Problem {
 		var problems []lint.Problem
 		resource := utils.GetResource(m)
-		for _, p := range resource.GetPattern() {
+		for ndx, p := range resource.GetPattern() {
 			components := strings.Split(p, "/")
 			for i, c := range components {
 				identifierExpected := i%2 == 1
@@ -41,7 +41,7 @@ var resourceNameComponentsAlternate = &lint.MessageRule{
 					problems = append(problems, lint.Problem{
 						Message:    fmt.Sprintf("Resource pattern %q must alternate between collection and identifier. %q is not an identifier", p, c),
 						Descriptor: m,
-						Location:   locations.MessageResource(m),
+						Location:   locations.MessageResourcePattern(m, ndx),
 					})
 					break
 				}
@@ -31,23 +31,27 @@ var resourcePattern = &lint.MessageRule{
 	OnlyIf: hasResourceAnnotation,
 	LintMessage: func(m *desc.MessageDescriptor) []lint.Problem {
 		resource := utils.GetResource(m)
-		return lintResourcePattern(resource, m, locations.MessageResource(m))
+		return lintResourcePattern(resource, m, func(i int) *dpb.SourceCodeInfo_Location {
+			return locations.MessageResourcePattern(m, i)
+		})
 	},
 }
 
-func lintResourcePattern(resource *annotations.ResourceDescriptor, desc desc.Descriptor, loc *dpb.SourceCodeInfo_Location) []lint.Problem {
+// lintResourcePattern lints the pattern(s) in the given ResourceDescriptor.
+// The patternLoc function returns the location of the pattern at an index.
+func lintResourcePattern(resource *annotations.ResourceDescriptor, desc desc.Descriptor, patternLoc func(int) *dpb.SourceCodeInfo_Location) []lint.Problem {
 	// Are any patterns declared at all? If not, complain.
 	if len(resource.GetPattern()) == 0 {
 		return []lint.Problem{{
 			Message:    "Resources should declare resource name pattern(s).",
 			Descriptor: desc,
-			Location:   loc,
+			Location:   patternLoc(0),
 		}}
 	}
 
 	// Ensure that the constant segments of the pattern uses camel case,
 	// not snake case, and there are no spaces.
-	for _, pattern := range resource.GetPattern() {
+	for i, pattern := range resource.GetPattern() {
 		plainPattern := getPlainPattern(pattern)
 
 		if strings.Contains(plainPattern, "_") {
@@ -57,14 +61,14 @@ func lintResourcePattern(resource *annotations.ResourceDescriptor, desc desc.Des
 					getDesiredPattern(pattern),
 				),
 				Descriptor: desc,
-				Location:   loc,
+				Location:   patternLoc(i),
 			}}
 		}
 		if strings.Contains(plainPattern, " ") {
 			return []lint.Problem{{
 				Message:    "Resource patterns should not have spaces",
 				Descriptor: desc,
-				Location:   loc,
+				Location:   patternLoc(i),
 			}}
 		}
 	}
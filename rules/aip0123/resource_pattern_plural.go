@@ -64,7 +64,7 @@ var resourcePatternPlural = &lint.MessageRule{
 				problems = append(problems, lint.Problem{
 					Message:    fmt.Sprintf("resource pattern %q collection segment must be the resource plural %q", pattern, want),
 					Descriptor: m,
-					Location:   locations.MessageResource(m),
+					Location:   locations.MessageResourcePattern(m, ndx),
 				})
 			}
 		}
@@ -56,7 +56,7 @@ var resourcePatternSingular = &lint.MessageRule{
 		// If the first pattern is reduced or non-compliant, but is nested name eligible, we want to recommend the nested name.
 		nestedFirstPattern := nested && (strings.HasSuffix(patterns[0], nn) || !strings.HasSuffix(patterns[0], singular))
 
-		for _, pattern := range patterns {
+		for i, pattern := range patterns {
 			if !strings.HasSuffix(pattern, singular) {
 				// allow the reduced, nested name instead if present
 				if nested && strings.HasSuffix(pattern, nn) {
@@ -70,7 +70,7 @@ var resourcePatternSingular = &lint.MessageRule{
 				problems = append(problems, lint.Problem{
 					Message:    fmt.Sprintf("resource pattern %q final segment must include the resource singular %q", pattern, want),
 					Descriptor: m,
-					Location:   locations.MessageResource(m),
+					Location:   locations.MessageResourcePattern(m, i),
 				})
 			}
 		}
@@ -17,6 +17,7 @@ package aip0123
 import (
 	"testing"
 
+	"github.com/googleapis/api-linter/locations"
 	"github.com/googleapis/api-linter/rules/internal/testutils"
 )
 
@@ -55,3 +56,26 @@ func TestResourcePattern(t *testing.T) {
 		})
 	}
 }
+
+func TestResourcePatternLocation(t *testing.T) {
+	f := testutils.ParseProto3String(t, `
+		import "google/api/resource.proto";
+
+		message Book {
+			option (google.api.resource) = {
+				type: "library.googleapis.com/Book"
+				pattern: "publishers/{publisher}/books/{book}"
+				pattern: "book_publishers/{book_publisher}/books/{book}"
+			};
+			string name = 1;
+		}
+	`)
+	m := f.GetMessageTypes()[0]
+	problems := resourcePattern.Lint(f)
+	if len(problems) != 1 {
+		t.Fatalf("Got %d problems; want 1", len(problems))
+	}
+	if got, want := problems[0].Location, locations.MessageResourcePattern(m, 1); got != want {
+		t.Errorf("Got location %v; want the second pattern at %v", got.GetSpan(), want.GetSpan())
+	}
+}
@@ -32,16 +32,19 @@ var resourceVariables = &lint.MessageRule{
 	LintMessage: func(m *desc.MessageDescriptor) []lint.Problem {
 		resource := utils.GetResource(m)
 
-		return lintResourceVariables(resource, m, locations.MessageResource(m))
+		return lintResourceVariables(resource, m, func(i int) *dpb.SourceCodeInfo_Location {
+			return locations.MessageResourcePattern(m, i)
+		})
 	},
 }
 
 // lintResourceVariables lints the resource ID segments of the pattern(s) in the
 // give ResourceDescriptor. This is used for both the file-level annotation
 // google.api.resource_definition and the message-level annotation
-// google.api.resource.
-func lintResourceVariables(resource *annotations.ResourceDescriptor, desc desc.Descriptor, loc *dpb.SourceCodeInfo_Location) []lint.Problem {
-	for _, pattern := range resource.GetPattern() {
+// google.api.resource. The patternLoc function returns the location of the
+// pattern at an index.
+func lintResourceVariables(resource *annotations.ResourceDescriptor, desc desc.Descriptor, patternLoc func(int) *dpb.SourceCodeInfo_Location) []lint.Problem {
+	for i, pattern := range resource.GetPattern() {
 		for _, variable := range getVariables(pattern) {
 			if strings.ToLower(variable) != variable {
 				return []lint.Problem{{
@@ -50,7 +53,7 @@ func lintResourceVariables(resource *annotations.ResourceDescriptor, desc desc.D
 						getDesiredPattern(pattern),
 					),
 					Descriptor: desc,
-					Location:   loc,
+					Location:   patternLoc(i),
 				}}
 			}
 			if strings.HasSuffix(variable, "_id") {
@@ -60,7 +63,7 @@ func lintResourceVariables(resource *annotations.ResourceDescriptor, desc desc.D
 						getDesiredPattern(pattern),
 					),
 					Descriptor: desc,
-					Location:   loc,
+					Location:   patternLoc(i),
 				}}
 			}
 		}
@@ -99,8 +99,8 @@ func anyMatch(regex *regexp.Regexp, strs []string) bool {
 
 // Checks whether the HTTP pattern specified in `resourceRef` matches any of the
 // patterns defined for that resource.
-func checkHTTPPatternMatchesResource(m *desc.MethodDescriptor, resourceRef resourceReference) []lint.Problem {
-	annotation := utils.FindResource(resourceRef.resourceRefName, m.GetFile())
+func checkHTTPPatternMatchesResource(m *desc.MethodDescriptor, resourceRef resourceReference, set *lint.FileSet) []lint.Problem {
+	annotation := utils.FindResource(resourceRef.resourceRefName, m.GetFile(), set)
 	if annotation == nil {
 		return []lint.Problem{}
 	}
@@ -118,19 +118,21 @@ func checkHTTPPatternMatchesResource(m *desc.MethodDescriptor, resourceRef resou
 	return []lint.Problem{}
 }
 
-var httpTemplatePattern = &lint.MethodRule{
-	Name: lint.NewRuleName(127, "http-template-pattern"),
-	OnlyIf: func(m *desc.MethodDescriptor) bool {
-		return len(methodResourceReferences(m)) > 0
-	},
-	LintMethod: func(m *desc.MethodDescriptor) []lint.Problem {
-		problems := []lint.Problem{}
-
-		resourceRefs := methodResourceReferences(m)
-		for _, resourceRef := range resourceRefs {
-			problems = append(problems, checkHTTPPatternMatchesResource(m, resourceRef)...)
-		}
-
-		return problems
-	},
-}
+var httpTemplatePattern = lint.NewFileSetRule(func(set *lint.FileSet) lint.ProtoRule {
+	return &lint.MethodRule{
+		Name: lint.NewRuleName(127, "http-template-pattern"),
+		OnlyIf: func(m *desc.MethodDescriptor) bool {
+			return len(methodResourceReferences(m)) > 0
+		},
+		LintMethod: func(m *desc.MethodDescriptor) []lint.Problem {
+			problems := []lint.Problem{}
+
+			resourceRefs := methodResourceReferences(m)
+			for _, resourceRef := range resourceRefs {
+				problems = append(problems, checkHTTPPatternMatchesResource(m, resourceRef, set)...)
+			}
+
+			return problems
+		},
+	}
+})
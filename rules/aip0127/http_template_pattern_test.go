@@ -202,3 +202,30 @@ func TestHttpTemplatePattern_SkipCheckIfFieldPathMissingResourceAnnotation(t *te
 		t.Errorf("%v", problems)
 	}
 }
+
+func TestHttpTemplatePattern_SkipCheckIfFieldPathTraversesScalar(t *testing.T) {
+	f := testutils.ParseProto3String(t, `
+			import "google/api/annotations.proto";
+			import "google/api/resource.proto";
+			service Library {
+				rpc GetBook(GetBookRequest) returns (Book) {
+					option (google.api.http) = {
+						get: "/v1/{name.value=shelves}"
+					};
+				}
+			}
+			message GetBookRequest {
+				string name = 1 [(google.api.resource_reference).type = "library.googleapis.com/Book"];
+			}
+			message Book {
+				option (google.api.resource) = {
+					type: "library.googleapis.com/Book"
+					pattern: "books"
+				};
+				string name = 1;
+			}
+		`)
+	if problems := httpTemplatePattern.Lint(f); len(problems) > 0 {
+		t.Errorf("%v", problems)
+	}
+}
@@ -45,7 +45,7 @@ var httpTemplateSyntax = &lint.MethodRule{
 	OnlyIf: utils.HasHTTPRules,
 	LintMethod: func(m *desc.MethodDescriptor) []lint.Problem {
 		problems := []lint.Problem{}
-		for _, httpRule := range utils.GetHTTPRules(m) {
+		for i, httpRule := range utils.GetHTTPRules(m) {
 			// Replace the API Versioning template if it matches exactly so as
 			// to not emit false positives.
 			uri := utils.VersionedSegment.ReplaceAllString(httpRule.URI, "v")
@@ -54,7 +54,7 @@ var httpTemplateSyntax = &lint.MethodRule{
 				problems = append(problems, lint.Problem{
 					Message:    message,
 					Descriptor: m,
-					Location:   locations.MethodHTTPRule(m),
+					Location:   locations.MethodHTTPRuleURI(m, i),
 				})
 			}
 		}
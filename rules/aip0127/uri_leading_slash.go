@@ -26,12 +26,12 @@ import (
 var leadingSlash = &lint.MethodRule{
 	Name: lint.NewRuleName(127, "uri-leading-slash"),
 	LintMethod: func(m *desc.MethodDescriptor) []lint.Problem {
-		for _, http := range utils.GetHTTPRules(m) {
+		for i, http := range utils.GetHTTPRules(m) {
 			if !strings.HasPrefix(http.GetPlainURI(), "/") {
 				return []lint.Problem{{
 					Message:    "URIs must begin with a leading slash.",
 					Descriptor: m,
-					Location:   locations.MethodHTTPRule(m),
+					Location:   locations.MethodHTTPRuleURI(m, i),
 				}}
 			}
 		}
@@ -41,13 +41,13 @@ var httpBody = &lint.MethodRule{
 		}
 
 		// Establish that HTTP body the RPC should map the resource field name in the request message.
-		for _, httpRule := range utils.GetHTTPRules(m) {
+		for i, httpRule := range utils.GetHTTPRules(m) {
 			if httpRule.Body == "" {
 				// Establish that the RPC should have HTTP body
 				return []lint.Problem{{
 					Message:    "Post methods should have an HTTP body.",
 					Descriptor: m,
-					Location:   locations.MethodHTTPRule(m),
+					Location:   locations.MethodHTTPRuleBody(m, i),
 				}}
 				// When resource field is not set in the request message, the problem
 				// will not be triggered by the rule"core::0133::http-body". It will be
@@ -61,7 +61,7 @@ var httpBody = &lint.MethodRule{
 						resourceFieldName,
 					),
 					Descriptor: m,
-					Location:   locations.MethodHTTPRule(m),
+					Location:   locations.MethodHTTPRuleBody(m, i),
 				}}
 			}
 		}
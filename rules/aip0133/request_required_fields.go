@@ -59,6 +59,7 @@ var requestRequiredFields = &lint.MethodRule{
 				problems = append(problems, lint.Problem{
 					Message:    fmt.Sprintf("Create RPCs must only require fields explicitly described in AIPs, not %q.", f.GetName()),
 					Descriptor: f,
+					Location:   utils.FieldBehaviorLocation(f, "REQUIRED"),
 				})
 			}
 		}
@@ -31,12 +31,12 @@ var httpBody = &lint.MethodRule{
 	LintMethod: func(m *desc.MethodDescriptor) []lint.Problem {
 		fieldName := strcase.SnakeCase(m.GetName()[6:])
 		// Establish that the RPC has HTTP body equal to fieldName.
-		for _, httpRule := range utils.GetHTTPRules(m) {
+		for i, httpRule := range utils.GetHTTPRules(m) {
 			if httpRule.Body != fieldName {
 				return []lint.Problem{{
 					Message:    fmt.Sprintf("Update methods should have an HTTP body equal to `%q`.", fieldName),
 					Descriptor: m,
-					Location:   locations.MethodHTTPRule(m),
+					Location:   locations.MethodHTTPRuleBody(m, i),
 				}}
 			}
 		}
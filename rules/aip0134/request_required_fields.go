@@ -50,6 +50,7 @@ var requestRequiredFields = &lint.MethodRule{
 				problems = append(problems, lint.Problem{
 					Message:    fmt.Sprintf("Update RPCs must only require fields explicitly described in AIPs, not %q.", f.GetName()),
 					Descriptor: f,
+					Location:   utils.FieldBehaviorLocation(f, "REQUIRED"),
 				})
 			}
 		}
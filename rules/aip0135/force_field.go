@@ -21,31 +21,33 @@ import (
 )
 
 // Delete methods for resources that are parents should have a bool force field.
-var forceField = &lint.MessageRule{
-	Name: lint.NewRuleName(135, "force-field"),
-	OnlyIf: func(m *desc.MessageDescriptor) bool {
-		name := m.FindFieldByName("name")
-		ref := utils.GetResourceReference(name)
-		validRef := ref != nil && ref.GetType() != "" && utils.FindResource(ref.GetType(), m.GetFile()) != nil
+var forceField = lint.NewFileSetRule(func(set *lint.FileSet) lint.ProtoRule {
+	return &lint.MessageRule{
+		Name: lint.NewRuleName(135, "force-field"),
+		OnlyIf: func(m *desc.MessageDescriptor) bool {
+			name := m.FindFieldByName("name")
+			ref := utils.GetResourceReference(name)
+			validRef := ref != nil && ref.GetType() != "" && utils.FindResource(ref.GetType(), m.GetFile(), set) != nil
 
-		return utils.IsDeleteRequestMessage(m) && validRef
-	},
-	LintMessage: func(m *desc.MessageDescriptor) []lint.Problem {
-		force := m.FindFieldByName("force")
-		name := m.FindFieldByName("name")
-		ref := utils.GetResourceReference(name)
-		res := utils.FindResource(ref.GetType(), m.GetFile())
+			return utils.IsDeleteRequestMessage(m) && validRef
+		},
+		LintMessage: func(m *desc.MessageDescriptor) []lint.Problem {
+			force := m.FindFieldByName("force")
+			name := m.FindFieldByName("name")
+			ref := utils.GetResourceReference(name)
+			res := utils.FindResource(ref.GetType(), m.GetFile(), set)
 
-		children := utils.FindResourceChildren(res, m.GetFile())
-		if len(children) > 0 && force == nil {
-			return []lint.Problem{
-				{
-					Message:    "Delete requests for resources with children should have a `bool force` field",
-					Descriptor: m,
-				},
+			children := utils.FindResourceChildren(res, m.GetFile(), set)
+			if len(children) > 0 && force == nil {
+				return []lint.Problem{
+					{
+						Message:    "Delete requests for resources with children should have a `bool force` field",
+						Descriptor: m,
+					},
+				}
 			}
-		}
 
-		return nil
-	},
-}
+			return nil
+		},
+	}
+})
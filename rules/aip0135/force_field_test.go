@@ -17,6 +17,7 @@ package aip0135
 import (
 	"testing"
 
+	"github.com/googleapis/api-linter/lint"
 	"github.com/googleapis/api-linter/rules/internal/testutils"
 )
 
@@ -70,3 +71,58 @@ func TestForceField(t *testing.T) {
 		})
 	}
 }
+
+func TestForceField_FileSet(t *testing.T) {
+	files := testutils.ParseProtoStrings(t, map[string]string{
+		"publisher.proto": `
+			syntax = "proto3";
+			package test;
+
+			import "google/api/resource.proto";
+
+			message Publisher {
+				option (google.api.resource) = {
+					type: "library.googleapis.com/Publisher"
+					pattern: "publishers/{publisher}"
+				};
+
+				string name = 1;
+			}
+
+			message DeletePublisherRequest {
+				string name = 1 [(google.api.resource_reference).type = "library.googleapis.com/Publisher"];
+			}
+		`,
+		"book.proto": `
+			syntax = "proto3";
+			package test;
+
+			import "google/api/resource.proto";
+
+			option (google.api.resource_definition) = {
+				type: "library.googleapis.com/Book"
+				pattern: "publishers/{publisher}/books/{book}"
+			};
+		`,
+	})
+	for _, test := range []struct {
+		name     string
+		set      *lint.FileSet
+		problems testutils.Problems
+	}{
+		// Without the FileSet, the rule only looks at publisher.proto and its
+		// imports, where Publisher has no children.
+		{"NoFileSet", nil, nil},
+		// book.proto is not imported, but the child it defines is in the
+		// FileSet of the lint run.
+		{"ChildInFileSet", lint.NewFileSet(files["publisher.proto"], files["book.proto"]), testutils.Problems{{Message: "bool force"}}},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			f := files["publisher.proto"]
+			problems := forceField.WithFileSet(test.set).Lint(f)
+			if diff := test.problems.SetDescriptor(f.FindMessage("test.DeletePublisherRequest")).Diff(problems); diff != "" {
+				t.Errorf("Problems did not match: %v", diff)
+			}
+		})
+	}
+}
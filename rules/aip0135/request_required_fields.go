@@ -43,6 +43,7 @@ var requestRequiredFields = &lint.MessageRule{
 				problems = append(problems, lint.Problem{
 					Message:    fmt.Sprintf("Delete RPCs must only require fields explicitly described in AIPs, not %q.", f.GetName()),
 					Descriptor: f,
+					Location:   utils.FieldBehaviorLocation(f, "REQUIRED"),
 				})
 			}
 		}
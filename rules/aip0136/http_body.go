@@ -28,8 +28,8 @@ import (
 var httpBody = &lint.MethodRule{
 	Name:   lint.NewRuleName(136, "http-body"),
 	OnlyIf: utils.IsCustomMethod,
-	LintMethod: func(m *desc.MethodDescriptor) []lint.Problem {
-		for _, httpRule := range utils.GetHTTPRules(m) {
+	LintMethod: func(m *desc.MethodDescriptor) (problems []lint.Problem) {
+		for i, httpRule := range utils.GetHTTPRules(m) {
 			noBody := stringset.New("GET", "DELETE")
 			if !noBody.Contains(httpRule.Method) {
 				// Determine the name of the resource.
@@ -37,26 +37,20 @@ var httpBody = &lint.MethodRule{
 				// word and that the resource is everything else.
 				resource := strings.Join(strings.Split(strcase.SnakeCase(m.GetName()), "_")[1:], "_")
 				if !stringset.New(resource, "*").Contains(httpRule.Body) {
-					// FIXME: We intentionally only return one Problem here.
-					// When we can attach problems to the particular annotation, update this
-					// to return multiples.
-					return []lint.Problem{{
+					problems = append(problems, lint.Problem{
 						Message:    "Custom POST methods should set `body: \"*\"`.",
 						Descriptor: m,
-						Location:   locations.MethodHTTPRule(m),
-					}}
+						Location:   locations.MethodHTTPRuleBody(m, i),
+					})
 				}
 			} else if noBody.Contains(httpRule.Method) && httpRule.Body != "" {
-				// FIXME: We intentionally only return one Problem here.
-				// When we can attach problems to the particular annotation, update this
-				// to return multiples.
-				return []lint.Problem{{
+				problems = append(problems, lint.Problem{
 					Message:    "Custom GET (or DELETE) methods should not set a body clause.",
 					Descriptor: m,
-					Location:   locations.MethodHTTPRule(m),
-				}}
+					Location:   locations.MethodHTTPRuleBody(m, i),
+				})
 			}
 		}
-		return nil
+		return problems
 	},
 }
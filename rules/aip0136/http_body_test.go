@@ -17,6 +17,7 @@ package aip0136
 import (
 	"testing"
 
+	"github.com/googleapis/api-linter/locations"
 	"github.com/googleapis/api-linter/rules/internal/testutils"
 )
 
@@ -60,3 +61,34 @@ func TestHttpBody(t *testing.T) {
 		})
 	}
 }
+
+func TestHttpBodyAdditionalBindings(t *testing.T) {
+	file := testutils.ParseProto3String(t, `
+		import "google/api/annotations.proto";
+		service Library {
+			rpc ArchiveBook(ArchiveBookRequest) returns (ArchiveBookResponse) {
+				option (google.api.http) = {
+					post: "/v1/{name=publishers/*/books/*}:archive"
+					body: "random"
+					additional_bindings {
+						post: "/v1/{name=books/*}:archive"
+						body: "other"
+					}
+				};
+			}
+		}
+		message ArchiveBookRequest {}
+		message ArchiveBookResponse {}
+	`)
+	method := file.GetServices()[0].GetMethods()[0]
+	want := testutils.Problems{{Message: `body: "*"`}, {Message: `body: "*"`}}
+	problems := httpBody.Lint(file)
+	if diff := want.SetDescriptor(method).Diff(problems); diff != "" {
+		t.Fatalf(diff)
+	}
+	for i, p := range problems {
+		if got, want := p.Location, locations.MethodHTTPRuleBody(method, i); got != want {
+			t.Errorf("Problem %d has location %v; want the body of binding %d at %v", i, got.GetSpan(), i, want.GetSpan())
+		}
+	}
+}
@@ -35,12 +35,12 @@ var httpMethod = &lint.MethodRule{
 		}
 
 		// Run the normal check for POST or GET.
-		for _, httpRule := range utils.GetHTTPRules(m) {
+		for i, httpRule := range utils.GetHTTPRules(m) {
 			if httpRule.Method != "POST" && httpRule.Method != "GET" {
 				return []lint.Problem{{
 					Message:    "Custom methods should use the HTTP POST or GET method.",
 					Descriptor: m,
-					Location:   locations.MethodHTTPRule(m),
+					Location:   locations.MethodHTTPRuleURI(m, i),
 				}}
 			}
 		}
@@ -30,7 +30,7 @@ var httpNameVariable = &lint.MethodRule{
 	OnlyIf: utils.IsCustomMethod,
 	LintMethod: func(m *desc.MethodDescriptor) []lint.Problem {
 		p := pluralize.NewClient()
-		for _, http := range utils.GetHTTPRules(m) {
+		for i, http := range utils.GetHTTPRules(m) {
 			vars := http.GetVariables()
 
 			// Special case: AIP-162 describes "revision" methods; the `name`
@@ -53,7 +53,7 @@ var httpNameVariable = &lint.MethodRule{
 					return []lint.Problem{{
 						Message:    "The name variable should only be used if the RPC noun matches the URI.",
 						Descriptor: m,
-						Location:   locations.MethodHTTPRule(m),
+						Location:   locations.MethodHTTPRuleURI(m, i),
 					}}
 				}
 			}
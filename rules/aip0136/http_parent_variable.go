@@ -30,7 +30,7 @@ var httpParentVariable = &lint.MethodRule{
 	OnlyIf: utils.IsCustomMethod,
 	LintMethod: func(m *desc.MethodDescriptor) []lint.Problem {
 		p := pluralize.NewClient()
-		for _, http := range utils.GetHTTPRules(m) {
+		for i, http := range utils.GetHTTPRules(m) {
 			vars := http.GetVariables()
 
 			// If there is a "parent" variable, the noun should be present
@@ -48,7 +48,7 @@ var httpParentVariable = &lint.MethodRule{
 					return []lint.Problem{{
 						Message:    "The parent variable should only be used if the RPC noun matches the URI.",
 						Descriptor: m,
-						Location:   locations.MethodHTTPRule(m),
+						Location:   locations.MethodHTTPRuleURI(m, i),
 					}}
 				}
 			}
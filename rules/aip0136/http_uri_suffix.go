@@ -31,8 +31,8 @@ var uriSuffix = &lint.MethodRule{
 	OnlyIf: func(m *desc.MethodDescriptor) bool {
 		return utils.IsCustomMethod(m) && httpNameVariable.LintMethod(m) == nil && httpParentVariable.LintMethod(m) == nil
 	},
-	LintMethod: func(m *desc.MethodDescriptor) []lint.Problem {
-		for _, httpRule := range utils.GetHTTPRules(m) {
+	LintMethod: func(m *desc.MethodDescriptor) (problems []lint.Problem) {
+		for i, httpRule := range utils.GetHTTPRules(m) {
 			var want string
 
 			// URIs should end in a `:` character followed by the name of the method.
@@ -100,19 +100,16 @@ var uriSuffix = &lint.MethodRule{
 
 			// Do we have the suffix we expect?
 			if !strings.HasSuffix(httpRule.URI, want) {
-				// FIXME: We intentionally only return one Problem here.
-				// When we can attach issues to the particular annotation, update this
-				// to return multiples.
-				return []lint.Problem{{
+				problems = append(problems, lint.Problem{
 					Message: fmt.Sprintf(
 						"Custom method should have a URI suffix matching the method name, such as %q.",
 						want,
 					),
 					Descriptor: m,
-					Location:   locations.MethodHTTPRule(m),
-				}}
+					Location:   locations.MethodHTTPRuleURI(m, i),
+				})
 			}
 		}
-		return nil
+		return problems
 	},
 }
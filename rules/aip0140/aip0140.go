@@ -26,17 +26,19 @@ import (
 
 // AddRules adds all of the AIP-140 rules to the provided registry.
 func AddRules(r lint.RuleRegistry) error {
-	return r.Register(
+	if err := r.Register(
 		140,
 		abbreviations,
 		base64,
-		lowerSnake,
 		noPrepositions,
 		numbers,
 		reservedWords,
 		underscores,
 		uri,
-	)
+	); err != nil {
+		return err
+	}
+	return r.RegisterReflect(140, lowerSnake)
 }
 
 // toLowerSnakeCase converts s to lower_snake_case.
@@ -19,19 +19,19 @@ import (
 
 	"github.com/googleapis/api-linter/lint"
 	"github.com/googleapis/api-linter/locations"
-	"github.com/jhump/protoreflect/desc"
+	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
 // Field names must be snake case.
-var lowerSnake = &lint.FieldRule{
+var lowerSnake = &lint.ReflectFieldRule{
 	Name: lint.NewRuleName(140, "lower-snake"),
-	LintField: func(f *desc.FieldDescriptor) []lint.Problem {
-		if got, want := f.GetName(), toLowerSnakeCase(f.GetName()); got != want {
-			return []lint.Problem{{
+	LintField: func(f protoreflect.FieldDescriptor) []lint.ReflectProblem {
+		if got, want := string(f.Name()), toLowerSnakeCase(string(f.Name())); got != want {
+			return []lint.ReflectProblem{{
 				Message:    fmt.Sprintf("Field `%s` must use lower_snake_case.", got),
 				Suggestion: want,
 				Descriptor: f,
-				Location:   locations.DescriptorName(f),
+				Location:   locations.ReflectDescriptorName(f),
 			}}
 		}
 		return nil
@@ -17,6 +17,9 @@ package aip0140
 import (
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
+	"github.com/googleapis/api-linter/lint"
+	"github.com/googleapis/api-linter/locations"
 	"github.com/googleapis/api-linter/rules/internal/testutils"
 	"github.com/jhump/protoreflect/desc/builder"
 )
@@ -48,10 +51,40 @@ func TestLowerSnake(t *testing.T) {
 
 			// Run the lint rule and verify that we got the expected set
 			// of problems.
-			problems := lowerSnake.Lint(message.GetFile())
+			problems := lint.AdaptReflectRule(lowerSnake).Lint(message.GetFile())
 			if diff := test.problems.SetDescriptor(message.GetFields()[0]).Diff(problems); diff != "" {
 				t.Errorf(diff)
 			}
 		})
 	}
 }
+
+func TestLowerSnake_LintFiles(t *testing.T) {
+	f := testutils.ParseProto3String(t, `
+		message Book {
+			string hasRating = 1;
+		}
+	`)
+	rules := lint.NewRuleRegistry()
+	if err := AddRules(rules); err != nil {
+		t.Fatal(err)
+	}
+	configs := lint.Configs{{EnabledRules: []string{"core::0140::lower-snake"}, DisabledRules: []string{"all"}}}
+	// The rule runs on the google.golang.org/protobuf descriptors given to
+	// LintFiles, and reports the location of the field's name.
+	resp, err := lint.New(rules, configs).LintFiles(f.UnwrapFile())
+	if err != nil {
+		t.Fatal(err)
+	}
+	field := f.GetMessageTypes()[0].GetFields()[0]
+	if len(resp[0].Problems) != 1 {
+		t.Fatalf("Got problems %v; want one", resp[0].Problems)
+	}
+	p := resp[0].Problems[0]
+	if p.Suggestion != "has_rating" || p.Descriptor.GetFullyQualifiedName() != field.GetFullyQualifiedName() {
+		t.Errorf("Got problem %q on %s; want has_rating on %s", p.Suggestion, p.Descriptor.GetFullyQualifiedName(), field.GetFullyQualifiedName())
+	}
+	if diff := cmp.Diff(locations.DescriptorName(field).GetSpan(), p.Location.GetSpan()); diff != "" {
+		t.Errorf("Got location diff (-want +got):\n%s", diff)
+	}
+}
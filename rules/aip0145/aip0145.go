@@ -0,0 +1,46 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package aip0145 contains rules defined in https://aip.dev/145.
+package aip0145
+
+import (
+	"strings"
+
+	"github.com/googleapis/api-linter/lint"
+	"github.com/jhump/protoreflect/desc"
+)
+
+// AddRules adds all of the AIP-145 rules to the provided registry.
+func AddRules(r lint.RuleRegistry) error {
+	return r.Register(
+		145,
+		rangeHalfOpen,
+		rangePairs,
+	)
+}
+
+// counterpart returns the name of the other field of a range for a field
+// named like `start_*` or `end_*`, or an empty string.
+func counterpart(f *desc.FieldDescriptor) string {
+	name := f.GetName()
+	switch {
+	case strings.HasPrefix(name, "start_"):
+		return "end_" + strings.TrimPrefix(name, "start_")
+	case strings.HasPrefix(name, "end_") && !strings.HasPrefix(name, "end_user"):
+		// "End users" are not ranges.
+		return "start_" + strings.TrimPrefix(name, "end_")
+	}
+	return ""
+}
@@ -0,0 +1,45 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aip0145
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/googleapis/api-linter/lint"
+	"github.com/googleapis/api-linter/rules/internal/utils"
+	"github.com/jhump/protoreflect/desc"
+)
+
+var rangeHalfOpen = &lint.FieldRule{
+	Name: lint.NewRuleName(145, "range-half-open"),
+	OnlyIf: func(f *desc.FieldDescriptor) bool {
+		return strings.HasPrefix(f.GetName(), "end_") && counterpart(f) != "" &&
+			f.GetOwner().FindFieldByName(counterpart(f)) != nil
+	},
+	LintField: func(f *desc.FieldDescriptor) []lint.Problem {
+		comment := strings.Join(utils.SeparateInternalComments(f.GetSourceInfo().GetLeadingComments()).External, "\n")
+		if exclusiveRegexp.MatchString(comment) {
+			return nil
+		}
+		return []lint.Problem{{
+			Message:    fmt.Sprintf("Ranges are half-open: the comment of `%s` should document that the end is exclusive.", f.GetName()),
+			Descriptor: f,
+		}}
+	},
+}
+
+var exclusiveRegexp = regexp.MustCompile(`(?i)\bexclusive\b|\bhalf-open\b`)
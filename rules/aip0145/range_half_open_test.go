@@ -0,0 +1,52 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aip0145
+
+import (
+	"testing"
+
+	"github.com/googleapis/api-linter/rules/internal/testutils"
+)
+
+func TestRangeHalfOpen(t *testing.T) {
+	for _, test := range []struct {
+		name     string
+		Comment  string
+		Start    string
+		problems testutils.Problems
+	}{
+		{"Valid", "The end of the range (exclusive).", "start_page", nil},
+		{"ValidHalfOpen", "The end of the half-open range.", "start_page", nil},
+		{"Inclusive", "The last page (inclusive).", "start_page", testutils.Problems{{Message: "exclusive"}}},
+		{"Undocumented", "The end of the range.", "start_page", testutils.Problems{{Message: "exclusive"}}},
+		{"InternalOnly", "The end. (-- Exclusive. --)", "start_page", testutils.Problems{{Message: "exclusive"}}},
+		{"NoStart", "The end of the range.", "first_page", nil},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			f := testutils.ParseProto3Tmpl(t, `
+				message Book {
+					int32 {{.Start}} = 1;
+
+					// {{.Comment}}
+					int32 end_page = 2;
+				}
+			`, test)
+			field := f.GetMessageTypes()[0].GetFields()[1]
+			if diff := test.problems.SetDescriptor(field).Diff(rangeHalfOpen.Lint(f)); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aip0145
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/googleapis/api-linter/lint"
+	"github.com/googleapis/api-linter/locations"
+	"github.com/googleapis/api-linter/rules/internal/utils"
+	"github.com/jhump/protoreflect/desc"
+)
+
+var rangePairs = &lint.FieldRule{
+	Name: lint.NewRuleName(145, "range-pairs"),
+	OnlyIf: func(f *desc.FieldDescriptor) bool {
+		return counterpart(f) != ""
+	},
+	LintField: func(f *desc.FieldDescriptor) []lint.Problem {
+		want := counterpart(f)
+		other := f.GetOwner().FindFieldByName(want)
+		if other == nil {
+			return []lint.Problem{{
+				Message:    fmt.Sprintf("Field `%s` should be paired with an `%s` field of the same type.", f.GetName(), want),
+				Descriptor: f,
+			}}
+		}
+		// Report mismatched types once, on the end field.
+		if strings.HasPrefix(f.GetName(), "end_") && typeOf(f) != typeOf(other) {
+			return []lint.Problem{{
+				Message:    fmt.Sprintf("Fields `%s` and `%s` must have the same type.", other.GetName(), f.GetName()),
+				Suggestion: typeOf(other),
+				Descriptor: f,
+				Location:   locations.FieldType(f),
+			}}
+		}
+		return nil
+	},
+}
+
+func typeOf(f *desc.FieldDescriptor) string {
+	if f.IsRepeated() && !f.IsMap() {
+		return "repeated " + utils.GetTypeName(f)
+	}
+	return utils.GetTypeName(f)
+}
@@ -0,0 +1,55 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aip0145
+
+import (
+	"testing"
+
+	"github.com/googleapis/api-linter/rules/internal/testutils"
+)
+
+func TestRangePairs(t *testing.T) {
+	for _, test := range []struct {
+		name     string
+		Fields   string
+		problems testutils.Problems
+	}{
+		{"Valid", "int32 start_page = 1; int32 end_page = 2;", nil},
+		{"ValidMessage", "google.protobuf.Timestamp start_time = 1; google.protobuf.Timestamp end_time = 2;", nil},
+		{"MissingEnd", "int32 start_page = 1; int32 last_page = 2;", testutils.Problems{{Message: "`end_page`"}}},
+		{"MissingStart", "int32 first_page = 1; int32 end_page = 2;", testutils.Problems{{Message: "`start_page`"}}},
+		{"TypeMismatch", "google.protobuf.Timestamp start_time = 1; string end_time = 2;", testutils.Problems{{Message: "same type", Suggestion: "google.protobuf.Timestamp"}}},
+		{"RepeatedMismatch", "repeated int32 start_page = 1; int32 end_page = 2;", testutils.Problems{{Message: "same type", Suggestion: "repeated int32"}}},
+		{"EndUser", "string end_user = 1; string title = 2;", nil},
+		{"Irrelevant", "string title = 1; string startup = 2;", nil},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			f := testutils.ParseProto3Tmpl(t, `
+				import "google/protobuf/timestamp.proto";
+
+				message Book {
+					{{.Fields}}
+				}
+			`, test)
+			var d = f.GetMessageTypes()[0].GetFields()[0]
+			if test.name != "MissingEnd" {
+				d = f.GetMessageTypes()[0].GetFields()[1]
+			}
+			if diff := test.problems.SetDescriptor(d).Diff(rangePairs.Lint(f)); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
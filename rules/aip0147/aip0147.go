@@ -0,0 +1,100 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package aip0147 contains rules defined in https://aip.dev/147.
+package aip0147
+
+import (
+	"fmt"
+	"strings"
+
+	"bitbucket.org/creachadair/stringset"
+	"github.com/googleapis/api-linter/lint"
+	"github.com/googleapis/api-linter/rules/internal/utils"
+	"github.com/jhump/protoreflect/desc"
+)
+
+// AddRules adds all of the AIP-147 rules to the provided registry.
+func AddRules(r lint.RuleRegistry) error {
+	return r.Register(
+		147,
+		httpPath,
+		inputOnly,
+		methodSignature,
+		resourcePattern,
+	)
+}
+
+// defaultNames are the field names that are sensitive unless configured
+// otherwise.
+var defaultNames = stringset.New("password", "secret", "private_key", "token", "credentials")
+
+// exemptNames are field names that match a sensitive name but are not
+// sensitive.
+var exemptNames = stringset.New("page_token", "next_page_token")
+
+// sensitiveRule is a rule checking the fields with sensitive names. It
+// accepts the following options:
+//
+//   - names: the sensitive names, replacing the defaults.
+//   - additional_names: sensitive names in addition to the defaults (or to
+//     the configured names).
+type sensitiveRule struct {
+	lint.ProtoRule
+	build func(names stringset.Set) lint.ProtoRule
+}
+
+func newSensitiveRule(build func(names stringset.Set) lint.ProtoRule) *sensitiveRule {
+	return &sensitiveRule{ProtoRule: build(defaultNames), build: build}
+}
+
+// WithOptions returns the rule checking the configured names.
+func (r *sensitiveRule) WithOptions(opts lint.RuleOptions) (lint.ProtoRule, error) {
+	for key := range opts {
+		if key != "names" && key != "additional_names" {
+			return nil, fmt.Errorf("unknown option %q", key)
+		}
+	}
+	names := defaultNames
+	if configured, err := opts.StringSlice("names"); err != nil {
+		return nil, err
+	} else if configured != nil {
+		names = stringset.New(configured...)
+	}
+	additional, err := opts.StringSlice("additional_names")
+	if err != nil {
+		return nil, err
+	}
+	return r.build(names.Union(stringset.New(additional...))), nil
+}
+
+// isSensitiveName returns true if the name is one of the sensitive names, or
+// ends with one of them (such as `admin_password`).
+func isSensitiveName(name string, names stringset.Set) bool {
+	if exemptNames.Contains(name) {
+		return false
+	}
+	for n := range names {
+		if name == n || strings.HasSuffix(name, "_"+n) {
+			return true
+		}
+	}
+	return false
+}
+
+// isSensitiveField returns true if the field has a sensitive name. Resource
+// references are not sensitive, as the field holds a resource name.
+func isSensitiveField(f *desc.FieldDescriptor, names stringset.Set) bool {
+	return f != nil && !utils.HasResourceReference(f) && isSensitiveName(f.GetName(), names)
+}
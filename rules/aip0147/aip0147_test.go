@@ -0,0 +1,101 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aip0147
+
+import (
+	"testing"
+
+	"bitbucket.org/creachadair/stringset"
+	"github.com/googleapis/api-linter/lint"
+	"github.com/googleapis/api-linter/rules/internal/testutils"
+)
+
+func TestAddRules(t *testing.T) {
+	if err := AddRules(lint.NewRuleRegistry()); err != nil {
+		t.Errorf("AddRules got an error: %v", err)
+	}
+}
+
+func TestIsSensitiveName(t *testing.T) {
+	for _, test := range []struct {
+		name string
+		want bool
+	}{
+		{"password", true},
+		{"admin_password", true},
+		{"private_key", true},
+		{"token", true},
+		{"page_token", false},
+		{"next_page_token", false},
+		{"passwords_enabled", false},
+		{"keyboard", false},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			if got := isSensitiveName(test.name, defaultNames); got != test.want {
+				t.Errorf("isSensitiveName(%q) = %v; want %v", test.name, got, test.want)
+			}
+		})
+	}
+}
+
+func TestWithOptions(t *testing.T) {
+	f := testutils.ParseProto3String(t, `
+		message Book {
+			string password = 1;
+			string ssn = 2;
+		}
+	`)
+	fields := f.GetMessageTypes()[0].GetFields()
+	for _, test := range []struct {
+		name     string
+		opts     lint.RuleOptions
+		problems testutils.Problems
+	}{
+		{"Defaults", nil, testutils.Problems{{Descriptor: fields[0]}}},
+		{"Additional", lint.RuleOptions{"additional_names": []interface{}{"ssn"}}, testutils.Problems{{Descriptor: fields[0]}, {Descriptor: fields[1]}}},
+		{"Replaced", lint.RuleOptions{"names": []interface{}{"ssn"}}, testutils.Problems{{Descriptor: fields[1]}}},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			rule, err := inputOnly.WithOptions(test.opts)
+			if err != nil {
+				t.Fatalf("WithOptions(%v) got an error: %v", test.opts, err)
+			}
+			if diff := test.problems.Diff(rule.Lint(f)); diff != "" {
+				t.Errorf(diff)
+			}
+		})
+	}
+}
+
+func TestWithOptions_Invalid(t *testing.T) {
+	for _, opts := range []lint.RuleOptions{
+		{"names": "password"},
+		{"additional_names": []interface{}{1}},
+		{"name": []interface{}{"password"}},
+	} {
+		if _, err := inputOnly.WithOptions(opts); err == nil {
+			t.Errorf("WithOptions(%v) succeeded; want an error", opts)
+		}
+	}
+}
+
+func TestWithOptions_DefaultsUnchanged(t *testing.T) {
+	if _, err := inputOnly.WithOptions(lint.RuleOptions{"additional_names": []interface{}{"ssn"}}); err != nil {
+		t.Fatal(err)
+	}
+	if !defaultNames.Equals(stringset.New("password", "secret", "private_key", "token", "credentials")) {
+		t.Errorf("WithOptions changed the default names to %v", defaultNames)
+	}
+}
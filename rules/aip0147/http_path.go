@@ -0,0 +1,52 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aip0147
+
+import (
+	"fmt"
+	"sort"
+
+	"bitbucket.org/creachadair/stringset"
+	"github.com/googleapis/api-linter/lint"
+	"github.com/googleapis/api-linter/locations"
+	"github.com/googleapis/api-linter/rules/internal/utils"
+	"github.com/jhump/protoreflect/desc"
+)
+
+var httpPath = newSensitiveRule(func(names stringset.Set) lint.ProtoRule {
+	return &lint.MethodRule{
+		Name: lint.NewRuleName(147, "http-path"),
+		LintMethod: func(m *desc.MethodDescriptor) []lint.Problem {
+			var problems []lint.Problem
+			for i, httpRule := range utils.GetHTTPRules(m) {
+				var paths []string
+				for path := range httpRule.GetVariables() {
+					paths = append(paths, path)
+				}
+				sort.Strings(paths)
+				for _, path := range paths {
+					if isSensitiveField(utils.FindFieldDotNotation(m.GetInputType(), path), names) {
+						problems = append(problems, lint.Problem{
+							Message:    fmt.Sprintf("HTTP URI %q should not contain the sensitive field %q, as URIs are commonly logged.", httpRule.URI, path),
+							Descriptor: m,
+							Location:   locations.MethodHTTPRuleURI(m, i),
+						})
+					}
+				}
+			}
+			return problems
+		},
+	}
+})
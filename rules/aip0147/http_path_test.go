@@ -0,0 +1,67 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aip0147
+
+import (
+	"testing"
+
+	"github.com/googleapis/api-linter/rules/internal/testutils"
+)
+
+func TestHTTPPath(t *testing.T) {
+	for _, test := range []struct {
+		name     string
+		URI      string
+		problems testutils.Problems
+	}{
+		{"Valid", "/v1/{name=accounts/*}:login", nil},
+		{"ValidReference", "/v1/{name=accounts/*}/secrets/{secret}:login", nil},
+		{"InvalidField", "/v1/{name=accounts/*}/passwords/{password}:login", testutils.Problems{{Message: `sensitive field "password"`}}},
+		{"InvalidNested", "/v1/{auth.token=*}:login", testutils.Problems{{Message: `sensitive field "auth.token"`}}},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			f := testutils.ParseProto3Tmpl(t, `
+				import "google/api/annotations.proto";
+				import "google/api/resource.proto";
+
+				service Library {
+					rpc Login(LoginRequest) returns (LoginResponse) {
+						option (google.api.http) = {
+							post: "{{.URI}}"
+							body: "*"
+						};
+					}
+				}
+
+				message LoginRequest {
+					string name = 1;
+					string password = 2;
+					Auth auth = 3;
+					string secret = 4 [(google.api.resource_reference).type = "library.googleapis.com/Secret"];
+				}
+
+				message Auth {
+					string token = 1;
+				}
+
+				message LoginResponse {}
+			`, test)
+			m := f.GetServices()[0].GetMethods()[0]
+			if diff := test.problems.SetDescriptor(m).Diff(httpPath.Lint(f)); diff != "" {
+				t.Errorf(diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aip0147
+
+import (
+	"fmt"
+	"strings"
+
+	"bitbucket.org/creachadair/stringset"
+	"github.com/googleapis/api-linter/lint"
+	"github.com/googleapis/api-linter/rules/internal/utils"
+	"github.com/jhump/protoreflect/desc"
+)
+
+var inputOnly = newSensitiveRule(func(names stringset.Set) lint.ProtoRule {
+	return &lint.FieldRule{
+		Name: lint.NewRuleName(147, "input-only"),
+		OnlyIf: func(f *desc.FieldDescriptor) bool {
+			// Every field of a request message is input, and the fields of
+			// response messages are covered by the resources they return.
+			owner := f.GetOwner().GetName()
+			return isSensitiveField(f, names) && !strings.HasSuffix(owner, "Request") && !strings.HasSuffix(owner, "Response")
+		},
+		LintField: func(f *desc.FieldDescriptor) []lint.Problem {
+			if !utils.GetFieldBehavior(f).Contains("INPUT_ONLY") {
+				return []lint.Problem{{
+					Message:    fmt.Sprintf("Sensitive field %q should have `(google.api.field_behavior) = INPUT_ONLY`.", f.GetName()),
+					Descriptor: f,
+				}}
+			}
+			return nil
+		},
+	}
+})
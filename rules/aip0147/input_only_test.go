@@ -0,0 +1,55 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aip0147
+
+import (
+	"testing"
+
+	"github.com/googleapis/api-linter/rules/internal/testutils"
+)
+
+func TestInputOnly(t *testing.T) {
+	for _, test := range []struct {
+		name      string
+		Message   string
+		Field     string
+		Reference string
+		Behavior  string
+		problems  testutils.Problems
+	}{
+		{"Valid", "Account", "password", "", "[(google.api.field_behavior) = INPUT_ONLY]", nil},
+		{"ValidNotSensitive", "Account", "display_name", "", "", nil},
+		{"ValidPageToken", "Account", "page_token", "", "", nil},
+		{"ValidReference", "Account", "secret", `[(google.api.resource_reference).type = "library.googleapis.com/Secret"]`, "", nil},
+		{"ValidRequest", "CreateAccountRequest", "password", "", "", nil},
+		{"InvalidMissing", "Account", "password", "", "", testutils.Problems{{Message: "INPUT_ONLY"}}},
+		{"InvalidSuffix", "Account", "admin_token", "", "[(google.api.field_behavior) = REQUIRED]", testutils.Problems{{Message: "INPUT_ONLY"}}},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			f := testutils.ParseProto3Tmpl(t, `
+				import "google/api/field_behavior.proto";
+				import "google/api/resource.proto";
+
+				message {{.Message}} {
+					string {{.Field}} = 1 {{.Reference}}{{.Behavior}};
+				}
+			`, test)
+			field := f.GetMessageTypes()[0].GetFields()[0]
+			if diff := test.problems.SetDescriptor(field).Diff(inputOnly.Lint(f)); diff != "" {
+				t.Errorf(diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aip0147
+
+import (
+	"testing"
+
+	"github.com/googleapis/api-linter/rules/internal/testutils"
+)
+
+func TestMethodSignature(t *testing.T) {
+	for _, test := range []struct {
+		name      string
+		Signature string
+		problems  testutils.Problems
+	}{
+		{"Valid", "name,display_name", nil},
+		{"ValidUnresolved", "name,missing", nil},
+		{"InvalidField", "name,password", testutils.Problems{{Message: `sensitive field "password"`}}},
+		{"InvalidNested", "name,auth.secret", testutils.Problems{{Message: `sensitive field "auth.secret"`}}},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			f := testutils.ParseProto3Tmpl(t, `
+				import "google/api/client.proto";
+
+				service Library {
+					rpc Login(LoginRequest) returns (LoginResponse) {
+						option (google.api.method_signature) = "{{.Signature}}";
+					}
+				}
+
+				message LoginRequest {
+					string name = 1;
+					string display_name = 2;
+					string password = 3;
+					Auth auth = 4;
+				}
+
+				message Auth {
+					string secret = 1;
+				}
+
+				message LoginResponse {}
+			`, test)
+			m := f.GetServices()[0].GetMethods()[0]
+			if diff := test.problems.SetDescriptor(m).Diff(methodSignature.Lint(f)); diff != "" {
+				t.Errorf(diff)
+			}
+		})
+	}
+}
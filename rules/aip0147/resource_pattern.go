@@ -0,0 +1,50 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aip0147
+
+import (
+	"fmt"
+	"regexp"
+
+	"bitbucket.org/creachadair/stringset"
+	"github.com/googleapis/api-linter/lint"
+	"github.com/googleapis/api-linter/locations"
+	"github.com/googleapis/api-linter/rules/internal/utils"
+	"github.com/jhump/protoreflect/desc"
+)
+
+var patternVariable = regexp.MustCompile(`\{([^}=]+)`)
+
+var resourcePattern = newSensitiveRule(func(names stringset.Set) lint.ProtoRule {
+	return &lint.MessageRule{
+		Name:   lint.NewRuleName(147, "resource-pattern"),
+		OnlyIf: utils.IsResource,
+		LintMessage: func(m *desc.MessageDescriptor) []lint.Problem {
+			var problems []lint.Problem
+			for i, pattern := range utils.GetResource(m).GetPattern() {
+				for _, match := range patternVariable.FindAllStringSubmatch(pattern, -1) {
+					if isSensitiveName(match[1], names) {
+						problems = append(problems, lint.Problem{
+							Message:    fmt.Sprintf("Resource pattern %q should not contain the sensitive variable %q.", pattern, match[1]),
+							Descriptor: m,
+							Location:   locations.MessageResourcePattern(m, i),
+						})
+					}
+				}
+			}
+			return problems
+		},
+	}
+})
@@ -0,0 +1,85 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aip0149
+
+import (
+	"fmt"
+
+	"bitbucket.org/creachadair/stringset"
+	"github.com/googleapis/api-linter/lint"
+	"github.com/googleapis/api-linter/locations"
+	"github.com/googleapis/api-linter/rules/internal/utils"
+	"github.com/jhump/protoreflect/desc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Mutable primitive fields whose zero value is meaningful should track
+// presence, so that an unset value can be told apart from the zero value.
+var explicitPresence = &lint.FieldRule{
+	Name: lint.NewRuleName(149, "explicit-presence"),
+	OnlyIf: func(f *desc.FieldDescriptor) bool {
+		if f.GetFile().UnwrapFile().Syntax() == protoreflect.Proto2 {
+			return false
+		}
+		if f.IsRepeated() || f.GetOneOf() != nil || !primitiveKinds.Contains(f.UnwrapField().Kind().String()) {
+			return false
+		}
+		if behavior := utils.GetFieldBehavior(f); behavior.Contains("OUTPUT_ONLY") || behavior.Contains("IMMUTABLE") {
+			return false
+		}
+		m := f.GetOwner()
+		if utils.IsUpdateRequestMessage(m) {
+			return !standardUpdateFields.Contains(f.GetName())
+		}
+		return utils.IsResource(m) && utils.IsDeclarativeFriendlyMessage(m)
+	},
+	LintField: func(f *desc.FieldDescriptor) []lint.Problem {
+		if utils.HasExplicitPresence(f) {
+			return nil
+		}
+		want := "be `optional`"
+		if utils.IsEditions(f.GetFile()) {
+			want = "have `features.field_presence = EXPLICIT`"
+		}
+		return []lint.Problem{{
+			Message: fmt.Sprintf(
+				"Field `%s` should %s, so that an unset value can be told apart from %s.",
+				f.GetName(), want, zeroValue(f),
+			),
+			Descriptor: f,
+			Location:   locations.FieldPresence(f),
+		}}
+	},
+}
+
+// primitiveKinds are the kinds of fields whose zero value is likely to be
+// meaningful. Empty strings and bytes rarely are, and enums have an
+// unspecified value.
+var primitiveKinds = stringset.New(
+	"bool", "double", "float",
+	"int32", "int64", "uint32", "uint64", "sint32", "sint64",
+	"fixed32", "fixed64", "sfixed32", "sfixed64",
+)
+
+// standardUpdateFields are the fields of Update requests from AIP-134 and
+// related AIPs, whose zero value is the default behavior.
+var standardUpdateFields = stringset.New("allow_missing", "validate_only")
+
+func zeroValue(f *desc.FieldDescriptor) string {
+	if f.UnwrapField().Kind() == protoreflect.BoolKind {
+		return "`false`"
+	}
+	return "`0`"
+}
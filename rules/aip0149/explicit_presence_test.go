@@ -0,0 +1,80 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aip0149
+
+import (
+	"testing"
+
+	"github.com/googleapis/api-linter/rules/internal/testutils"
+)
+
+func TestExplicitPresence(t *testing.T) {
+	for _, test := range []struct {
+		name     string
+		Style    string
+		Message  string
+		Field    string
+		problems testutils.Problems
+	}{
+		{"DeclarativeFriendlyOptional", "DECLARATIVE_FRIENDLY", "Book", "optional int32 rating = 2;", nil},
+		{"DeclarativeFriendlyImplicit", "DECLARATIVE_FRIENDLY", "Book", "int32 rating = 2;", testutils.Problems{{Message: "`0`"}}},
+		{"DeclarativeFriendlyBool", "DECLARATIVE_FRIENDLY", "Book", "bool archived = 2;", testutils.Problems{{Message: "`false`"}}},
+		{"DeclarativeFriendlyString", "DECLARATIVE_FRIENDLY", "Book", "string title = 2;", nil},
+		{"DeclarativeFriendlyOutputOnly", "DECLARATIVE_FRIENDLY", "Book", "int32 rating = 2 [(google.api.field_behavior) = OUTPUT_ONLY];", nil},
+		{"DeclarativeFriendlyRepeated", "DECLARATIVE_FRIENDLY", "Book", "repeated int32 ratings = 2;", nil},
+		{"NotDeclarativeFriendly", "STYLE_UNSPECIFIED", "Book", "int32 rating = 2;", nil},
+		{"UpdateRequest", "STYLE_UNSPECIFIED", "UpdateBookRequest", "int32 rating = 2;", testutils.Problems{{Message: "optional"}}},
+		{"UpdateRequestOptional", "STYLE_UNSPECIFIED", "UpdateBookRequest", "optional int32 rating = 2;", nil},
+		{"UpdateRequestStandard", "STYLE_UNSPECIFIED", "UpdateBookRequest", "bool allow_missing = 2;", nil},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			f := testutils.ParseProto3Tmpl(t, `
+				import "google/api/field_behavior.proto";
+				import "google/api/resource.proto";
+
+				message {{.Message}} {
+					option (google.api.resource) = {
+						type: "library.googleapis.com/Book"
+						pattern: "books/{book}"
+						style: {{.Style}}
+					};
+
+					string name = 1;
+					{{.Field}}
+				}
+			`, test)
+			field := f.GetMessageTypes()[0].GetFields()[1]
+			if diff := test.problems.SetDescriptor(field).Diff(explicitPresence.Lint(f)); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestExplicitPresenceEditions(t *testing.T) {
+	f := testutils.ParseEditionsString(t, `
+		import "google/api/resource.proto";
+
+		message UpdateBookRequest {
+			int32 rating = 1;
+			int32 pages = 2 [features.field_presence = IMPLICIT];
+		}
+	`)
+	field := f.GetMessageTypes()[0].GetFields()[1]
+	want := testutils.Problems{{Message: "features.field_presence = EXPLICIT"}}
+	if diff := want.SetDescriptor(field).Diff(explicitPresence.Lint(f)); diff != "" {
+		t.Error(diff)
+	}
+}
@@ -0,0 +1,63 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aip0149
+
+import (
+	"fmt"
+
+	"github.com/googleapis/api-linter/lint"
+	"github.com/googleapis/api-linter/locations"
+	"github.com/googleapis/api-linter/rules/internal/utils"
+	"github.com/jhump/protoreflect/desc"
+)
+
+// Wrapper types predate proto3 `optional`, which should be used instead.
+// Repeated fields are exempt, since they do not track presence either way.
+var wrapperTypes = &lint.FieldRule{
+	Name: lint.NewRuleName(149, "wrapper-types"),
+	OnlyIf: func(f *desc.FieldDescriptor) bool {
+		_, ok := wrappers[utils.GetTypeName(f)]
+		return ok && !f.IsRepeated() && !utils.IsCommonProto(f.GetFile())
+	},
+	LintField: func(f *desc.FieldDescriptor) []lint.Problem {
+		typeName := utils.GetTypeName(f)
+		want := wrappers[typeName]
+		// The suggestion replaces the type only; the presence is declared
+		// apart from it.
+		message := fmt.Sprintf("Use `optional %s` rather than the `%s` wrapper type.", want, typeName)
+		if utils.IsEditions(f.GetFile()) {
+			message = fmt.Sprintf("Use `%s` with `features.field_presence = EXPLICIT` rather than the `%s` wrapper type.", want, typeName)
+		}
+		return []lint.Problem{{
+			Message:    message,
+			Suggestion: want,
+			Descriptor: f,
+			Location:   locations.FieldType(f),
+		}}
+	},
+}
+
+// wrappers maps the wrapper types to the primitive types they wrap.
+var wrappers = map[string]string{
+	"google.protobuf.BoolValue":   "bool",
+	"google.protobuf.BytesValue":  "bytes",
+	"google.protobuf.DoubleValue": "double",
+	"google.protobuf.FloatValue":  "float",
+	"google.protobuf.Int32Value":  "int32",
+	"google.protobuf.Int64Value":  "int64",
+	"google.protobuf.StringValue": "string",
+	"google.protobuf.UInt32Value": "uint32",
+	"google.protobuf.UInt64Value": "uint64",
+}
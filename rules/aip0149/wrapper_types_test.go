@@ -0,0 +1,69 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aip0149
+
+import (
+	"testing"
+
+	"github.com/googleapis/api-linter/rules/internal/testutils"
+)
+
+func TestWrapperTypes(t *testing.T) {
+	for _, test := range []struct {
+		name     string
+		Field    string
+		problems testutils.Problems
+	}{
+		{"Optional", "optional int32 rating = 1;", nil},
+		{"Int32Value", "google.protobuf.Int32Value rating = 1;", testutils.Problems{{Message: "`optional int32`", Suggestion: "int32"}}},
+		{"BoolValue", "google.protobuf.BoolValue archived = 1;", testutils.Problems{{Suggestion: "bool"}}},
+		{"Repeated", "repeated google.protobuf.StringValue tags = 1;", nil},
+		{"OtherMessage", "google.protobuf.Timestamp create_time = 1;", nil},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			f := testutils.ParseProto3Tmpl(t, `
+				import "google/protobuf/timestamp.proto";
+				import "google/protobuf/wrappers.proto";
+
+				message Book {
+					{{.Field}}
+				}
+			`, test)
+			field := f.GetMessageTypes()[0].GetFields()[0]
+			if diff := test.problems.SetDescriptor(field).Diff(wrapperTypes.Lint(f)); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestWrapperTypesEditions(t *testing.T) {
+	f := testutils.ParseEditionsString(t, `
+		import "google/protobuf/wrappers.proto";
+
+		message Book {
+			google.protobuf.Int32Value rating = 1;
+		}
+	`)
+	field := f.GetMessageTypes()[0].GetFields()[0]
+	want := testutils.Problems{{
+		Message:    "`int32` with `features.field_presence = EXPLICIT`",
+		Suggestion: "int32",
+		Descriptor: field,
+	}}
+	if diff := want.Diff(wrapperTypes.Lint(f)); diff != "" {
+		t.Error(diff)
+	}
+}
@@ -26,12 +26,12 @@ var httpURISuffix = &lint.MethodRule{
 	Name:   lint.NewRuleName(152, "http-uri-suffix"),
 	OnlyIf: isRunMethod,
 	LintMethod: func(m *desc.MethodDescriptor) []lint.Problem {
-		for _, httpRule := range utils.GetHTTPRules(m) {
+		for i, httpRule := range utils.GetHTTPRules(m) {
 			if !runURIRegexp.MatchString(httpRule.URI) {
 				return []lint.Problem{{
 					Message:    `Run URI should end with ":run".`,
 					Descriptor: m,
-					Location:   locations.MethodHTTPRule(m),
+					Location:   locations.MethodHTTPRuleURI(m, i),
 				}}
 			}
 		}
@@ -0,0 +1,127 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package aip0153 contains rules defined in https://aip.dev/153.
+package aip0153
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/googleapis/api-linter/lint"
+	"github.com/googleapis/api-linter/locations"
+	"github.com/googleapis/api-linter/rules/internal/utils"
+	"github.com/jhump/protoreflect/desc"
+)
+
+// AddRules accepts a register function and registers each of
+// this AIP's rules to it.
+func AddRules(r lint.RuleRegistry) error {
+	return r.Register(
+		153,
+		exportHTTPBody,
+		exportHTTPMethod,
+		exportMetadataMessageName,
+		exportRequestOutputConfig,
+		exportResponseLRO,
+		exportResponseMessageName,
+		importHTTPBody,
+		importHTTPMethod,
+		importMetadataMessageName,
+		importRequestInputConfig,
+		importResponseLRO,
+		importResponseMessageName,
+	)
+}
+
+// lintResponseLRO returns a lint function checking that the method returns
+// a long-running operation.
+func lintResponseLRO(verb string) func(*desc.MethodDescriptor) []lint.Problem {
+	return func(m *desc.MethodDescriptor) []lint.Problem {
+		if !utils.IsOperation(m.GetOutputType()) {
+			return []lint.Problem{{
+				Message:    fmt.Sprintf("%s methods should use an LRO.", verb),
+				Descriptor: m,
+				Location:   locations.MethodResponseType(m),
+				Suggestion: "google.longrunning.Operation",
+			}}
+		}
+		return nil
+	}
+}
+
+// lintOperationInfoType returns a lint function checking that a type of the
+// method's `google.longrunning.operation_info` annotation is named after the
+// method, such as `ImportBooksResponse` for `ImportBooks`. The kind is
+// "Response" or "Metadata".
+func lintOperationInfoType(verb, kind string) func(*desc.MethodDescriptor) []lint.Problem {
+	return func(m *desc.MethodDescriptor) []lint.Problem {
+		info := utils.GetOperationInfo(m)
+		if info == nil {
+			return nil
+		}
+		got := info.GetResponseType()
+		if kind == "Metadata" {
+			got = info.GetMetadataType()
+		}
+		// The type may be fully qualified.
+		got = got[strings.LastIndex(got, ".")+1:]
+		if want := m.GetName() + kind; got != want {
+			return []lint.Problem{{
+				Message:    fmt.Sprintf("%s methods should have the %s type %q, not %q.", verb, strings.ToLower(kind), want, got),
+				Descriptor: m,
+				Location:   locations.MethodOperationInfo(m),
+			}}
+		}
+		return nil
+	}
+}
+
+// lintConfigField returns a lint function checking that the request message
+// has a singular message field with the given name, whose message puts its
+// sources (or destinations) in a oneof.
+func lintConfigField(name, of string) func(*desc.MethodDescriptor) []lint.Problem {
+	return func(m *desc.MethodDescriptor) []lint.Problem {
+		f, problems := utils.LintFieldPresent(m.GetInputType(), name)
+		if f == nil {
+			return problems
+		}
+		if f.IsRepeated() || f.GetMessageType() == nil || f.IsMap() {
+			return []lint.Problem{{
+				Message:    fmt.Sprintf("The `%s` field should be a singular message.", name),
+				Descriptor: f,
+				Location:   locations.FieldType(f),
+			}}
+		}
+		if !hasOneof(f.GetMessageType()) {
+			return []lint.Problem{{
+				Message:    fmt.Sprintf("The `%s` message should put its %s in a oneof.", f.GetMessageType().GetName(), of),
+				Descriptor: f,
+				Location:   locations.FieldType(f),
+			}}
+		}
+		return nil
+	}
+}
+
+// hasOneof returns true if the message has a oneof, not counting the
+// synthetic oneofs of proto3 optional fields.
+func hasOneof(m *desc.MessageDescriptor) bool {
+	for _, o := range m.GetOneOfs() {
+		if !o.IsSynthetic() {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,54 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aip0153
+
+import (
+	"testing"
+
+	"github.com/googleapis/api-linter/rules/internal/testutils"
+)
+
+func TestImportMetadataMessageName(t *testing.T) {
+	for _, test := range []struct {
+		name         string
+		MethodName   string
+		MetadataType string
+		problems     testutils.Problems
+	}{
+		{"Valid", "ImportBooks", "ImportBooksMetadata", nil},
+		{"ValidQualified", "ImportBooks", "test.ImportBooksMetadata", nil},
+		{"Invalid", "ImportBooks", "BooksMetadata", testutils.Problems{{Message: `"ImportBooksMetadata"`}}},
+		{"Irrelevant", "AcquireBook", "BooksMetadata", nil},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			f := testutils.ParseProto3Tmpl(t, `
+				package test;
+				import "google/longrunning/operations.proto";
+				service Library {
+					rpc {{.MethodName}}(ImportBooksRequest) returns (google.longrunning.Operation) {
+						option (google.longrunning.operation_info) = {
+							metadata_type: "{{.MetadataType}}"
+						};
+					}
+				}
+				message ImportBooksRequest {}
+			`, test)
+			m := f.GetServices()[0].GetMethods()[0]
+			if diff := test.problems.SetDescriptor(m).Diff(importMetadataMessageName.Lint(f)); diff != "" {
+				t.Errorf(diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aip0153
+
+import (
+	"testing"
+
+	"github.com/googleapis/api-linter/rules/internal/testutils"
+	"github.com/jhump/protoreflect/desc"
+)
+
+func TestImportRequestInputConfig(t *testing.T) {
+	for _, test := range []struct {
+		name       string
+		MethodName string
+		Field      string
+		Config     string
+		problems   testutils.Problems
+		descriptor func(*desc.MessageDescriptor) desc.Descriptor
+	}{
+		{"Valid", "ImportBooks", "InputConfig input_config = 1;", "oneof source { GcsSource gcs_source = 1; }", nil, nil},
+		{"Missing", "ImportBooks", "", "", testutils.Problems{{Message: "no `input_config` field"}}, func(m *desc.MessageDescriptor) desc.Descriptor { return m }},
+		{"NotMessage", "ImportBooks", "string input_config = 1;", "", testutils.Problems{{Message: "singular message"}}, firstField},
+		{"Repeated", "ImportBooks", "repeated InputConfig input_config = 1;", "oneof source { GcsSource gcs_source = 1; }", testutils.Problems{{Message: "singular message"}}, firstField},
+		{"NoOneof", "ImportBooks", "InputConfig input_config = 1;", "GcsSource gcs_source = 1;", testutils.Problems{{Message: "sources in a oneof"}}, firstField},
+		{"OptionalOnly", "ImportBooks", "InputConfig input_config = 1;", "optional GcsSource gcs_source = 1;", testutils.Problems{{Message: "sources in a oneof"}}, firstField},
+		{"Irrelevant", "AcquireBooks", "", "", nil, nil},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			f := testutils.ParseProto3Tmpl(t, `
+				service Library {
+					rpc {{.MethodName}}({{.MethodName}}Request) returns (ImportBooksResponse);
+				}
+				message {{.MethodName}}Request {
+					{{.Field}}
+				}
+				message InputConfig {
+					{{.Config}}
+				}
+				message GcsSource {}
+				message ImportBooksResponse {}
+			`, test)
+			request := f.GetMessageTypes()[0]
+			var d desc.Descriptor = request
+			if test.descriptor != nil {
+				d = test.descriptor(request)
+			}
+			if diff := test.problems.SetDescriptor(d).Diff(importRequestInputConfig.Lint(f)); diff != "" {
+				t.Errorf(diff)
+			}
+		})
+	}
+}
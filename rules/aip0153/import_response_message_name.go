@@ -0,0 +1,28 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aip0153
+
+import (
+	"github.com/googleapis/api-linter/lint"
+	"github.com/googleapis/api-linter/rules/internal/utils"
+)
+
+// Import methods such as `ImportBooks` should have the LRO response type
+// `ImportBooksResponse`.
+var importResponseMessageName = &lint.MethodRule{
+	Name:       lint.NewRuleName(153, "import-response-message-name"),
+	OnlyIf:     utils.IsImportMethod,
+	LintMethod: lintOperationInfoType("Import", "Response"),
+}
@@ -26,12 +26,12 @@ import (
 var hardcodedHyphen = &lint.MethodRule{
 	Name: lint.NewRuleName(159, "hardcoded-hyphen"),
 	LintMethod: func(m *desc.MethodDescriptor) []lint.Problem {
-		for _, http := range utils.GetHTTPRules(m) {
+		for i, http := range utils.GetHTTPRules(m) {
 			if strings.Contains(http.GetPlainURI(), "/-/") {
 				return []lint.Problem{{
 					Message:    "URIs must not hard-code a `-` segment.",
 					Descriptor: m,
-					Location:   locations.MethodHTTPRule(m),
+					Location:   locations.MethodHTTPRuleURI(m, i),
 				}}
 			}
 		}
@@ -0,0 +1,56 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package aip0160 contains rules defined in https://aip.dev/160.
+package aip0160
+
+import (
+	"regexp"
+
+	"github.com/googleapis/api-linter/lint"
+	"github.com/jhump/protoreflect/desc"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// AddRules adds all of the AIP-160 rules to the provided registry.
+func AddRules(r lint.RuleRegistry) error {
+	return r.Register(
+		160,
+		filterComment,
+		filterExamples,
+	)
+}
+
+var (
+	filterMethodRegexp     = regexp.MustCompile("^(?:List|Search|Purge)(?:[A-Z]|$)")
+	filterReqMessageRegexp = regexp.MustCompile("^(?:List|Search|Purge)[A-Z]+[A-Za-z0-9]*Request$")
+)
+
+// Returns true if this is the `filter` field of a List, Search or Purge
+// request message, false otherwise.
+func isFilterField(f *desc.FieldDescriptor) bool {
+	return f.GetName() == "filter" && !f.IsRepeated() &&
+		f.GetType() == descriptorpb.FieldDescriptorProto_TYPE_STRING &&
+		filterReqMessageRegexp.MatchString(f.GetOwner().GetName())
+}
+
+// Returns true if this is a List, Search or Purge method with a `filter`
+// field, false otherwise.
+func isFilterMethod(m *desc.MethodDescriptor) bool {
+	if !filterMethodRegexp.MatchString(m.GetName()) {
+		return false
+	}
+	f := m.GetInputType().FindFieldByName("filter")
+	return f != nil && isFilterField(f)
+}
@@ -0,0 +1,43 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aip0160
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/googleapis/api-linter/lint"
+	"github.com/googleapis/api-linter/rules/internal/utils"
+	"github.com/jhump/protoreflect/desc"
+)
+
+var filterComment = &lint.FieldRule{
+	Name:   lint.NewRuleName(160, "filter-comment"),
+	OnlyIf: isFilterField,
+	LintField: func(f *desc.FieldDescriptor) []lint.Problem {
+		comment := strings.Join(utils.SeparateInternalComments(f.GetSourceInfo().GetLeadingComments()).External, "\n")
+		if filterSyntaxRegexp.MatchString(comment) {
+			return nil
+		}
+		return []lint.Problem{{
+			Message:    "The `filter` field comment should document the filter syntax or link to AIP-160.",
+			Descriptor: f,
+		}}
+	},
+}
+
+// filterSyntaxRegexp matches comments that link to AIP-160 or describe the
+// filter syntax.
+var filterSyntaxRegexp = regexp.MustCompile(`(?i)\baip[- ]?160\b|aip\.dev/160\b|\b(grammar|syntax)\b`)
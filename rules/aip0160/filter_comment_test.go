@@ -0,0 +1,53 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aip0160
+
+import (
+	"testing"
+
+	"github.com/googleapis/api-linter/rules/internal/testutils"
+)
+
+func TestFilterComment(t *testing.T) {
+	for _, test := range []struct {
+		name     string
+		Message  string
+		Comment  string
+		Field    string
+		problems testutils.Problems
+	}{
+		{"ValidLink", "ListBooksRequest", "Filter results, as described in https://aip.dev/160.", "string filter", nil},
+		{"ValidAIP", "SearchBooksRequest", "A filter following AIP-160.", "string filter", nil},
+		{"ValidSyntax", "PurgeBooksRequest", "The filter syntax is `field = value`, combined with AND and OR.", "string filter", nil},
+		{"Missing", "ListBooksRequest", "A filter.", "string filter", testutils.Problems{{Message: "AIP-160"}}},
+		{"InternalOnly", "ListBooksRequest", "A filter. (-- See AIP-160. --)", "string filter", testutils.Problems{{Message: "AIP-160"}}},
+		{"IrrelevantMessage", "GetBookRequest", "A filter.", "string filter", nil},
+		{"IrrelevantField", "ListBooksRequest", "A query.", "string query", nil},
+		{"IrrelevantType", "ListBooksRequest", "A filter.", "repeated string filter", nil},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			f := testutils.ParseProto3Tmpl(t, `
+				message {{.Message}} {
+					// {{.Comment}}
+					{{.Field}} = 1;
+				}
+			`, test)
+			field := f.GetMessageTypes()[0].GetFields()[0]
+			if diff := test.problems.SetDescriptor(field).Diff(filterComment.Lint(f)); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aip0160
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/googleapis/api-linter/lint"
+	"github.com/googleapis/api-linter/rules/internal/filter"
+	"github.com/googleapis/api-linter/rules/internal/utils"
+	"github.com/jhump/protoreflect/desc"
+)
+
+var filterExamples = &lint.MethodRule{
+	Name:   lint.NewRuleName(160, "filter-examples"),
+	OnlyIf: isFilterMethod,
+	LintMethod: func(m *desc.MethodDescriptor) []lint.Problem {
+		f := m.GetInputType().FindFieldByName("filter")
+		// Purge methods do not return their resources, so only the syntax
+		// of their examples is checked.
+		resource := utils.GetListResourceMessage(m)
+		var problems []lint.Problem
+		for _, example := range filterExamplesOf(f) {
+			expr, err := filter.Parse(example)
+			if err != nil {
+				problems = append(problems, lint.Problem{
+					Message:    fmt.Sprintf("The filter example %q is not a valid AIP-160 filter: %v.", example, err),
+					Descriptor: f,
+				})
+				continue
+			}
+			if resource == nil {
+				continue
+			}
+			for _, r := range filter.Restrictions(expr) {
+				member, ok := r.Comparable.(*filter.Member)
+				if !ok || r.Comparator == "" {
+					continue
+				}
+				if n := resolvedSegments(resource, member.Path); n < len(member.Path) {
+					problems = append(problems, lint.Problem{
+						Message: fmt.Sprintf(
+							"The filter example %q refers to `%s`, which is not a field of `%s`.",
+							example, strings.Join(member.Path[:n+1], "."), resource.GetName(),
+						),
+						Descriptor: f,
+					})
+				}
+			}
+		}
+		return problems
+	},
+}
+
+var (
+	exampleRegexp     = regexp.MustCompile(`(?i)\bexamples?\b`)
+	looseFieldsRegexp = regexp.MustCompile(`^google\.protobuf\.(Any|Struct|Value)$`)
+)
+
+// filterExamplesOf returns the example filters in the comment of a field:
+// the code spans that follow the first mention of "example".
+func filterExamplesOf(f *desc.FieldDescriptor) []string {
+	comment := strings.Join(utils.SeparateInternalComments(f.GetSourceInfo().GetLeadingComments()).External, "\n")
+	return utils.GetCodeSpansAfter(comment, exampleRegexp)
+}
+
+// resolvedSegments returns how many segments of a field path resolve on the
+// given message. Map fields are followed by a key, and the fields of
+// messages with dynamic fields, such as google.protobuf.Struct, are not
+// checked.
+func resolvedSegments(m *desc.MessageDescriptor, path []string) int {
+	for i := 0; i < len(path); i++ {
+		if m == nil {
+			// A scalar field cannot be traversed.
+			return i
+		}
+		if looseFieldsRegexp.MatchString(m.GetFullyQualifiedName()) {
+			return len(path)
+		}
+		f := m.FindFieldByName(path[i])
+		if f == nil {
+			return i
+		}
+		if f.IsMap() {
+			// The next segment is a map key.
+			i++
+			f = f.GetMapValueType()
+		}
+		m = f.GetMessageType()
+	}
+	return len(path)
+}
@@ -0,0 +1,98 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aip0160
+
+import (
+	"testing"
+
+	"github.com/googleapis/api-linter/rules/internal/testutils"
+)
+
+func TestFilterExamples(t *testing.T) {
+	for _, test := range []struct {
+		name     string
+		Method   string
+		Comment  string
+		problems testutils.Problems
+	}{
+		{"NoExamples", "ListBooks", "See `https://aip.dev/160`.", nil},
+		{"Valid", "ListBooks", "For example: `title = \"Dune\" AND rating > 4`.", nil},
+		{"ValidTraversal", "ListBooks", "Examples: `author.name:\"Herbert\"`, `NOT author.born < 1920`.", nil},
+		{"ValidMapKey", "ListBooks", "For example, `labels.genre = \"fiction\"`.", nil},
+		{"ValidStruct", "ListBooks", "For example, `metadata.anything.goes = 1`.", nil},
+		{"ValidGlobal", "ListBooks", "For example, `Dune`.", nil},
+		{"ValidSearch", "SearchBooks", "For example, `title = \"Dune\"`.", nil},
+		{"InvalidSyntax", "ListBooks", "For example, `title = `.", testutils.Problems{{Message: "not a valid AIP-160 filter"}}},
+		{"UnknownField", "ListBooks", "For example, `titel = \"Dune\"`.", testutils.Problems{{Message: "`titel`"}}},
+		{"UnknownNestedField", "ListBooks", "For example, `author.nam = \"Herbert\"`.", testutils.Problems{{Message: "`author.nam`"}}},
+		{"ScalarTraversal", "ListBooks", "For example, `title.length > 4`.", testutils.Problems{{Message: "`title.length`"}}},
+		{"Several", "ListBooks", "Examples: `a = 1`, `(b`.", testutils.Problems{{Message: "`a`"}, {Message: "not a valid"}}},
+		{"PurgeSyntaxOnly", "PurgeBooks", "For example, `titel = \"Dune\"`.", nil},
+		{"PurgeInvalidSyntax", "PurgeBooks", "For example, `titel =`.", testutils.Problems{{Message: "not a valid"}}},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			f := testutils.ParseProto3Tmpl(t, `
+				import "google/protobuf/struct.proto";
+
+				service Library {
+					rpc {{.Method}}({{.Method}}Request) returns ({{.Method}}Response);
+				}
+
+				message {{.Method}}Request {
+					// {{.Comment}}
+					string filter = 1;
+				}
+
+				message {{.Method}}Response {
+					repeated Book books = 1;
+				}
+
+				message Book {
+					string title = 1;
+					int32 rating = 2;
+					Author author = 3;
+					map<string, string> labels = 4;
+					google.protobuf.Struct metadata = 5;
+				}
+
+				message Author {
+					string name = 1;
+					int32 born = 2;
+				}
+			`, test)
+			if test.Method == "PurgeBooks" {
+				// Purge responses do not hold the resources.
+				f = testutils.ParseProto3Tmpl(t, `
+					service Library {
+						rpc PurgeBooks(PurgeBooksRequest) returns (PurgeBooksResponse);
+					}
+
+					message PurgeBooksRequest {
+						// {{.Comment}}
+						string filter = 1;
+					}
+
+					message PurgeBooksResponse {
+						repeated string purge_sample = 1;
+					}
+				`, test)
+			}
+			field := f.GetMessageTypes()[0].GetFields()[0]
+			if diff := test.problems.SetDescriptor(field).Diff(filterExamples.Lint(f)); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,120 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package aip0161 contains rules defined in https://aip.dev/161.
+package aip0161
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/googleapis/api-linter/lint"
+	"github.com/googleapis/api-linter/rules/internal/utils"
+	"github.com/jhump/protoreflect/desc"
+)
+
+// AddRules adds all of the AIP-161 rules to the provided registry.
+func AddRules(r lint.RuleRegistry) error {
+	return r.Register(
+		161,
+		maskPaths,
+		updateMaskOutputOnly,
+	)
+}
+
+// maskTarget returns the resource that the field masks of a Get, List or
+// Update method apply to, or nil.
+func maskTarget(m *desc.MethodDescriptor) *desc.MessageDescriptor {
+	switch {
+	case utils.IsListMethod(m):
+		return utils.GetListResourceMessage(m)
+	case utils.IsGetMethod(m), utils.IsUpdateMethod(m):
+		return utils.GetResponseType(m)
+	}
+	return nil
+}
+
+// hasMaskTarget returns true if the method has field masks and a resource
+// they apply to.
+func hasMaskTarget(m *desc.MethodDescriptor) bool {
+	return len(maskFields(m)) > 0 && maskTarget(m) != nil
+}
+
+// maskFields returns the `update_mask` and `read_mask` fields of the request.
+func maskFields(m *desc.MethodDescriptor) []*desc.FieldDescriptor {
+	var fields []*desc.FieldDescriptor
+	for _, name := range []string{"update_mask", "read_mask"} {
+		f := m.GetInputType().FindFieldByName(name)
+		if f == nil || f.IsRepeated() {
+			continue
+		}
+		if t := f.GetMessageType(); t != nil && t.GetFullyQualifiedName() == "google.protobuf.FieldMask" {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+var maskIntroRegexp = regexp.MustCompile(`(?i)\b(examples?|defaults?)\b`)
+
+// documentedPaths returns the field mask paths documented in the comment of
+// a mask field: the comma-separated paths in the code spans that follow the
+// first mention of an example or a default.
+func documentedPaths(f *desc.FieldDescriptor) []string {
+	comment := strings.Join(utils.SeparateInternalComments(f.GetSourceInfo().GetLeadingComments()).External, "\n")
+	var paths []string
+	for _, span := range utils.GetCodeSpansAfter(comment, maskIntroRegexp) {
+		for _, path := range strings.Split(span, ",") {
+			if path = strings.TrimSpace(path); path != "" {
+				paths = append(paths, path)
+			}
+		}
+	}
+	return paths
+}
+
+// resolvePath returns the fields named by the segments of a field mask path,
+// starting from the given message. Following AIP-161, the segment after a map
+// field is a key, and `*` selects every element of a repeated field or map.
+// A path of `*` alone selects every field and resolves to no fields. The
+// second return value is the unresolved prefix of the path, if any.
+func resolvePath(m *desc.MessageDescriptor, path string) ([]*desc.FieldDescriptor, string) {
+	if path == "*" {
+		return nil, ""
+	}
+	segments := strings.Split(path, ".")
+	var fields []*desc.FieldDescriptor
+	for i := 0; i < len(segments); i++ {
+		var f *desc.FieldDescriptor
+		if m != nil {
+			f = m.FindFieldByName(segments[i])
+		}
+		if f == nil {
+			return fields, strings.Join(segments[:i+1], ".")
+		}
+		fields = append(fields, f)
+		switch {
+		case f.IsMap():
+			// Skip the key, or the wildcard.
+			i++
+			m = f.GetMapValueType().GetMessageType()
+		case f.IsRepeated() && i+1 < len(segments) && segments[i+1] == "*":
+			i++
+			m = f.GetMessageType()
+		default:
+			m = f.GetMessageType()
+		}
+	}
+	return fields, ""
+}
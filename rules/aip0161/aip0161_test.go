@@ -0,0 +1,78 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aip0161
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/googleapis/api-linter/lint"
+	"github.com/googleapis/api-linter/rules/internal/testutils"
+)
+
+func TestAddRules(t *testing.T) {
+	if err := AddRules(lint.NewRuleRegistry()); err != nil {
+		t.Errorf("AddRules got an error: %v", err)
+	}
+}
+
+func TestResolvePath(t *testing.T) {
+	f := testutils.ParseProto3String(t, `
+		message Book {
+			string title = 1;
+			Author author = 2;
+			repeated Author editors = 3;
+			map<string, string> labels = 4;
+			map<string, Author> contributors = 5;
+		}
+		message Author {
+			string name = 1;
+		}
+	`)
+	book := f.GetMessageTypes()[0]
+	for _, test := range []struct {
+		path           string
+		wantFields     []string
+		wantUnresolved string
+	}{
+		{"*", nil, ""},
+		{"title", []string{"title"}, ""},
+		{"author.name", []string{"author", "name"}, ""},
+		{"editors", []string{"editors"}, ""},
+		{"editors.*.name", []string{"editors", "name"}, ""},
+		{"labels.genre", []string{"labels"}, ""},
+		{"labels.*", []string{"labels"}, ""},
+		{"contributors.foreword.name", []string{"contributors", "name"}, ""},
+		{"titel", nil, "titel"},
+		{"author.nam", []string{"author"}, "author.nam"},
+		{"title.length", []string{"title"}, "title.length"},
+		{"title.*", []string{"title"}, "title.*"},
+		{"labels.genre.name", []string{"labels"}, "labels.genre.name"},
+	} {
+		t.Run(test.path, func(t *testing.T) {
+			fields, unresolved := resolvePath(book, test.path)
+			var got []string
+			for _, f := range fields {
+				got = append(got, f.GetName())
+			}
+			if diff := cmp.Diff(test.wantFields, got); diff != "" {
+				t.Errorf("resolvePath(%q) got fields diff (-want +got):\n%s", test.path, diff)
+			}
+			if unresolved != test.wantUnresolved {
+				t.Errorf("resolvePath(%q) got unresolved %q; want %q", test.path, unresolved, test.wantUnresolved)
+			}
+		})
+	}
+}
@@ -0,0 +1,45 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aip0161
+
+import (
+	"fmt"
+
+	"github.com/googleapis/api-linter/lint"
+	"github.com/jhump/protoreflect/desc"
+)
+
+var maskPaths = &lint.MethodRule{
+	Name:   lint.NewRuleName(161, "mask-paths"),
+	OnlyIf: hasMaskTarget,
+	LintMethod: func(m *desc.MethodDescriptor) []lint.Problem {
+		resource := maskTarget(m)
+		var problems []lint.Problem
+		for _, f := range maskFields(m) {
+			for _, path := range documentedPaths(f) {
+				if _, unresolved := resolvePath(resource, path); unresolved != "" {
+					problems = append(problems, lint.Problem{
+						Message: fmt.Sprintf(
+							"The `%s` path %q does not resolve: `%s` is not a field of `%s`.",
+							f.GetName(), path, unresolved, resource.GetName(),
+						),
+						Descriptor: f,
+					})
+				}
+			}
+		}
+		return problems
+	},
+}
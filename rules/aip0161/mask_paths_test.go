@@ -0,0 +1,76 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aip0161
+
+import (
+	"testing"
+
+	"github.com/googleapis/api-linter/rules/internal/testutils"
+)
+
+func TestMaskPaths(t *testing.T) {
+	for _, test := range []struct {
+		name     string
+		Method   string
+		Request  string
+		Response string
+		Mask     string
+		Comment  string
+		problems testutils.Problems
+	}{
+		{"ValidUpdate", "UpdateBook", "UpdateBookRequest", "Book", "update_mask", "For example, `title,author.name`.", nil},
+		{"ValidGet", "GetBook", "GetBookRequest", "Book", "read_mask", "Defaults to `*`.", nil},
+		{"ValidList", "ListBooks", "ListBooksRequest", "ListBooksResponse", "read_mask", "For example, `labels.genre`.", nil},
+		{"NoExamples", "UpdateBook", "UpdateBookRequest", "Book", "update_mask", "The fields to update, such as `titel`.", nil},
+		{"InvalidUpdate", "UpdateBook", "UpdateBookRequest", "Book", "update_mask", "For example, `title,autor.name`.", testutils.Problems{{Message: "`autor`"}}},
+		{"InvalidGet", "GetBook", "GetBookRequest", "Book", "read_mask", "Defaults to `author.nam`.", testutils.Problems{{Message: "`author.nam`"}}},
+		{"InvalidList", "ListBooks", "ListBooksRequest", "ListBooksResponse", "read_mask", "For example, `titel`.", testutils.Problems{{Message: "`titel`"}}},
+		{"IrrelevantMethod", "ArchiveBook", "ArchiveBookRequest", "Book", "update_mask", "For example, `titel`.", nil},
+		{"IrrelevantField", "UpdateBook", "UpdateBookRequest", "Book", "other_mask", "For example, `titel`.", nil},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			f := testutils.ParseProto3Tmpl(t, `
+				import "google/protobuf/field_mask.proto";
+
+				service Library {
+					rpc {{.Method}}({{.Request}}) returns ({{.Response}});
+				}
+
+				message {{.Request}} {
+					// {{.Comment}}
+					google.protobuf.FieldMask {{.Mask}} = 1;
+				}
+
+				message ListBooksResponse {
+					repeated Book books = 1;
+				}
+
+				message Book {
+					string title = 1;
+					Author author = 2;
+					map<string, string> labels = 3;
+				}
+
+				message Author {
+					string name = 1;
+				}
+			`, test)
+			field := f.GetMessageTypes()[0].GetFields()[0]
+			if diff := test.problems.SetDescriptor(field).Diff(maskPaths.Lint(f)); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aip0161
+
+import (
+	"fmt"
+
+	"github.com/googleapis/api-linter/lint"
+	"github.com/googleapis/api-linter/rules/internal/utils"
+	"github.com/jhump/protoreflect/desc"
+)
+
+var updateMaskOutputOnly = &lint.MethodRule{
+	Name: lint.NewRuleName(161, "update-mask-output-only"),
+	OnlyIf: func(m *desc.MethodDescriptor) bool {
+		return utils.IsUpdateMethod(m) && hasMaskTarget(m)
+	},
+	LintMethod: func(m *desc.MethodDescriptor) []lint.Problem {
+		var problems []lint.Problem
+		for _, f := range maskFields(m) {
+			if f.GetName() != "update_mask" {
+				continue
+			}
+			for _, path := range documentedPaths(f) {
+				fields, _ := resolvePath(maskTarget(m), path)
+				for _, field := range fields {
+					if utils.GetFieldBehavior(field).Contains("OUTPUT_ONLY") {
+						problems = append(problems, lint.Problem{
+							Message: fmt.Sprintf(
+								"The `update_mask` path %q names the output only field `%s`, which cannot be updated.",
+								path, field.GetName(),
+							),
+							Descriptor: f,
+						})
+						break
+					}
+				}
+			}
+		}
+		return problems
+	},
+}
@@ -0,0 +1,75 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aip0161
+
+import (
+	"testing"
+
+	"github.com/googleapis/api-linter/rules/internal/testutils"
+)
+
+func TestUpdateMaskOutputOnly(t *testing.T) {
+	for _, test := range []struct {
+		name     string
+		Method   string
+		Mask     string
+		Comment  string
+		problems testutils.Problems
+	}{
+		{"Valid", "UpdateBook", "update_mask", "For example, `title,author.name`.", nil},
+		{"ValidWildcard", "UpdateBook", "update_mask", "For example, `*`.", nil},
+		{"OutputOnly", "UpdateBook", "update_mask", "For example, `title,update_time`.", testutils.Problems{{Message: "`update_time`"}}},
+		{"OutputOnlyParent", "UpdateBook", "update_mask", "For example, `stats.views`.", testutils.Problems{{Message: "`stats`"}}},
+		{"OutputOnlyChild", "UpdateBook", "update_mask", "For example, `author.uid`.", testutils.Problems{{Message: "`uid`"}}},
+		{"IrrelevantReadMask", "GetBook", "read_mask", "For example, `update_time`.", nil},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			f := testutils.ParseProto3Tmpl(t, `
+				import "google/api/field_behavior.proto";
+				import "google/protobuf/field_mask.proto";
+				import "google/protobuf/timestamp.proto";
+
+				service Library {
+					rpc {{.Method}}({{.Method}}Request) returns (Book);
+				}
+
+				message {{.Method}}Request {
+					// {{.Comment}}
+					google.protobuf.FieldMask {{.Mask}} = 1;
+				}
+
+				message Book {
+					string title = 1;
+					Author author = 2;
+					google.protobuf.Timestamp update_time = 3 [(google.api.field_behavior) = OUTPUT_ONLY];
+					Stats stats = 4 [(google.api.field_behavior) = OUTPUT_ONLY];
+				}
+
+				message Author {
+					string name = 1;
+					string uid = 2 [(google.api.field_behavior) = OUTPUT_ONLY];
+				}
+
+				message Stats {
+					int64 views = 1;
+				}
+			`, test)
+			field := f.GetMessageTypes()[0].GetFields()[0]
+			if diff := test.problems.SetDescriptor(field).Diff(updateMaskOutputOnly.Lint(f)); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
@@ -26,12 +26,12 @@ var commitHTTPURISuffix = &lint.MethodRule{
 	Name:   lint.NewRuleName(162, "commit-http-uri-suffix"),
 	OnlyIf: utils.IsCommitRevisionMethod,
 	LintMethod: func(m *desc.MethodDescriptor) []lint.Problem {
-		for _, httpRule := range utils.GetHTTPRules(m) {
+		for i, httpRule := range utils.GetHTTPRules(m) {
 			if !commitURINameRegexp.MatchString(httpRule.URI) {
 				return []lint.Problem{{
 					Message:    `Commit URI should end with ":commit".`,
 					Descriptor: m,
-					Location:   locations.MethodHTTPRule(m),
+					Location:   locations.MethodHTTPRuleURI(m, i),
 				}}
 			}
 		}
@@ -26,12 +26,12 @@ var deleteRevisionHTTPURISuffix = &lint.MethodRule{
 	Name:   lint.NewRuleName(162, "delete-revision-http-uri-suffix"),
 	OnlyIf: utils.IsDeleteRevisionMethod,
 	LintMethod: func(m *desc.MethodDescriptor) []lint.Problem {
-		for _, httpRule := range utils.GetHTTPRules(m) {
+		for i, httpRule := range utils.GetHTTPRules(m) {
 			if !deleteRevisionURINameRegexp.MatchString(httpRule.URI) {
 				return []lint.Problem{{
 					Message:    `Delete Revision URI should end with ":deleteRevision".`,
 					Descriptor: m,
-					Location:   locations.MethodHTTPRule(m),
+					Location:   locations.MethodHTTPRuleURI(m, i),
 				}}
 			}
 		}
@@ -26,12 +26,12 @@ var rollbackHTTPURISuffix = &lint.MethodRule{
 	Name:   lint.NewRuleName(162, "rollback-http-uri-suffix"),
 	OnlyIf: utils.IsRollbackRevisionMethod,
 	LintMethod: func(m *desc.MethodDescriptor) []lint.Problem {
-		for _, httpRule := range utils.GetHTTPRules(m) {
+		for i, httpRule := range utils.GetHTTPRules(m) {
 			if !rollbackURINameRegexp.MatchString(httpRule.URI) {
 				return []lint.Problem{{
 					Message:    `Rollback URI should end with ":rollback".`,
 					Descriptor: m,
-					Location:   locations.MethodHTTPRule(m),
+					Location:   locations.MethodHTTPRuleURI(m, i),
 				}}
 			}
 		}
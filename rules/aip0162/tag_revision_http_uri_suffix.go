@@ -26,12 +26,12 @@ var tagRevisionHTTPURISuffix = &lint.MethodRule{
 	Name:   lint.NewRuleName(162, "tag-revision-http-uri-suffix"),
 	OnlyIf: utils.IsTagRevisionMethod,
 	LintMethod: func(m *desc.MethodDescriptor) []lint.Problem {
-		for _, httpRule := range utils.GetHTTPRules(m) {
+		for i, httpRule := range utils.GetHTTPRules(m) {
 			if !tagRevisionURINameRegexp.MatchString(httpRule.URI) {
 				return []lint.Problem{{
 					Message:    `Tag Revision URI should end with ":tagRevision".`,
 					Descriptor: m,
-					Location:   locations.MethodHTTPRule(m),
+					Location:   locations.MethodHTTPRuleURI(m, i),
 				}}
 			}
 		}
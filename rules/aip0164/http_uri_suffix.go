@@ -26,12 +26,12 @@ var httpURISuffix = &lint.MethodRule{
 	Name:   lint.NewRuleName(164, "http-uri-suffix"),
 	OnlyIf: isUndeleteMethod,
 	LintMethod: func(m *desc.MethodDescriptor) []lint.Problem {
-		for _, httpRule := range utils.GetHTTPRules(m) {
+		for i, httpRule := range utils.GetHTTPRules(m) {
 			if !undeleteURINameRegexp.MatchString(httpRule.URI) {
 				return []lint.Problem{{
 					Message:    `Undelete URI should end with ":undelete".`,
 					Descriptor: m,
-					Location:   locations.MethodHTTPRule(m),
+					Location:   locations.MethodHTTPRuleURI(m, i),
 				}}
 			}
 		}
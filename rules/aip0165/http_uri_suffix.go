@@ -26,12 +26,12 @@ var httpURISuffix = &lint.MethodRule{
 	Name:   lint.NewRuleName(165, "http-uri-suffix"),
 	OnlyIf: isPurgeMethod,
 	LintMethod: func(m *desc.MethodDescriptor) []lint.Problem {
-		for _, httpRule := range utils.GetHTTPRules(m) {
+		for i, httpRule := range utils.GetHTTPRules(m) {
 			if !purgeURINameRegexp.MatchString(httpRule.URI) {
 				return []lint.Problem{{
 					Message:    `Purge URI should end with ":purge".`,
 					Descriptor: m,
-					Location:   locations.MethodHTTPRule(m),
+					Location:   locations.MethodHTTPRuleURI(m, i),
 				}}
 			}
 		}
@@ -34,6 +34,8 @@ func AddRules(r lint.RuleRegistry) error {
 		javaMultipleFiles,
 		javaOuterClassname,
 		javaPackage,
+		lengthPrefixedMessages,
+		openEnums,
 		phpNamespace,
 		protoPkg,
 		rubyPackage,
@@ -30,6 +30,10 @@ var fileLayout = &lint.FileRule{
 					problems = append(problems, lint.Problem{
 						Message:    "Services should precede all messages.",
 						Descriptor: service,
+						RelatedLocations: []lint.RelatedLocation{{
+							Message:    "The first message is declared here.",
+							Descriptor: firstMessage,
+						}},
 					})
 				}
 			}
@@ -43,6 +47,10 @@ var fileLayout = &lint.FileRule{
 					problems = append(problems, lint.Problem{
 						Message:    "Messages should precede all top-level enums.",
 						Descriptor: firstEnum,
+						RelatedLocations: []lint.RelatedLocation{{
+							Message:    "The first message after this enum is declared here.",
+							Descriptor: message,
+						}},
 					})
 					break // Sending this over and over would be obnoxious.
 				}
@@ -59,7 +67,9 @@ var fileLayout = &lint.FileRule{
 //
 //	use `isAfter` if the goal is to know that `d` comes after `anchor`.
 func isBefore(anchor desc.Descriptor, d desc.Descriptor) bool {
-	return d.GetSourceInfo().GetSpan()[0] < anchor.GetSourceInfo().GetSpan()[0]
+	dLine, dOK := startLine(d)
+	anchorLine, anchorOK := startLine(anchor)
+	return dOK && anchorOK && dLine < anchorLine
 }
 
 // isBefore returns true if `d` is known to follow `anchor` in the file.
@@ -68,5 +78,17 @@ func isBefore(anchor desc.Descriptor, d desc.Descriptor) bool {
 //
 //	use `isBefore` if the goal is to know that `d` comes before `anchor`.
 func isAfter(anchor desc.Descriptor, d desc.Descriptor) bool {
-	return d.GetSourceInfo().GetSpan()[0] > anchor.GetSourceInfo().GetSpan()[0]
+	dLine, dOK := startLine(d)
+	anchorLine, anchorOK := startLine(anchor)
+	return dOK && anchorOK && dLine > anchorLine
+}
+
+// startLine returns the line on which `d` starts, and false if there is no
+// source info for it.
+func startLine(d desc.Descriptor) (int32, bool) {
+	span := d.GetSourceInfo().GetSpan()
+	if len(span) == 0 {
+		return 0, false
+	}
+	return span[0], true
 }
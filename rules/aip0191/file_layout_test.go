@@ -18,6 +18,7 @@ import (
 	"testing"
 
 	"github.com/googleapis/api-linter/rules/internal/testutils"
+	"github.com/jhump/protoreflect/desc"
 	"github.com/jhump/protoreflect/desc/builder"
 )
 
@@ -41,9 +42,13 @@ func TestFileLayout(t *testing.T) {
 			service Library {}
 		`)
 		want := testutils.Problems{{Descriptor: f.GetServices()[0]}}
-		if diff := want.Diff(fileLayout.Lint(f)); diff != "" {
+		got := fileLayout.Lint(f)
+		if diff := want.Diff(got); diff != "" {
 			t.Errorf(diff)
 		}
+		if related := got[0].RelatedLocations; len(related) != 1 || related[0].Descriptor != f.GetMessageTypes()[0] {
+			t.Errorf("Expected the first message as the related location, got %v", related)
+		}
 	})
 
 	t.Run("InvalidEnumBeforeMessage", func(t *testing.T) {
@@ -54,9 +59,13 @@ func TestFileLayout(t *testing.T) {
 			message Book {}
 		`)
 		want := testutils.Problems{{Descriptor: f.GetEnumTypes()[0]}}
-		if diff := want.Diff(fileLayout.Lint(f)); diff != "" {
+		got := fileLayout.Lint(f)
+		if diff := want.Diff(got); diff != "" {
 			t.Errorf(diff)
 		}
+		if related := got[0].RelatedLocations; len(related) != 1 || related[0].Descriptor != f.GetMessageTypes()[0] {
+			t.Errorf("Expected the message as the related location, got %v", related)
+		}
 	})
 
 	t.Run("NoSourceInfo", func(t *testing.T) {
@@ -72,4 +81,20 @@ func TestFileLayout(t *testing.T) {
 			t.Errorf("%v", problems)
 		}
 	})
+
+	t.Run("SourceInfoStripped", func(t *testing.T) {
+		parsed := testutils.ParseProto3String(t, `
+			message Book {}
+			service Library {}
+		`)
+		fdp := parsed.AsFileDescriptorProto()
+		fdp.SourceCodeInfo = nil
+		f, err := desc.CreateFileDescriptor(fdp)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if problems := fileLayout.Lint(f); len(problems) > 0 {
+			t.Errorf("%v", problems)
+		}
+	})
 }
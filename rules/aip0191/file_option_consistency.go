@@ -22,6 +22,7 @@ import (
 	"github.com/googleapis/api-linter/lint"
 	"github.com/googleapis/api-linter/locations"
 	"github.com/jhump/protoreflect/desc"
+	"google.golang.org/protobuf/reflect/protoreflect"
 	dpb "google.golang.org/protobuf/types/descriptorpb"
 )
 
@@ -63,6 +64,10 @@ var fileOptionConsistency = &lint.FileRule{
 						Message:    fmt.Sprintf("Option %q should be consistent throughout the package.", opt),
 						Descriptor: f,
 						Location:   locations.FilePackage(f),
+						RelatedLocations: []lint.RelatedLocation{
+							optionLocation(f, opt, valueFunc(opts)),
+							optionLocation(dep, opt, valueFunc(depOpts)),
+						},
 					})
 
 					// Sort the problems. It does not matter for actual use, but
@@ -77,3 +82,20 @@ var fileOptionConsistency = &lint.FileRule{
 		return
 	},
 }
+
+// optionLocation returns the location of the given file option, or of the
+// package statement if the option is not set.
+func optionLocation(f *desc.FileDescriptor, opt, value string) lint.RelatedLocation {
+	number := fileOptionFields.ByName(protoreflect.Name(opt)).Number()
+	loc := locations.FileOption(f, int(number))
+	if loc == nil {
+		loc = locations.FilePackage(f)
+	}
+	return lint.RelatedLocation{
+		Message:    fmt.Sprintf("Option %q is %q in %s.", opt, value, f.GetName()),
+		Descriptor: f,
+		Location:   loc,
+	}
+}
+
+var fileOptionFields = (&dpb.FileOptions{}).ProtoReflect().Descriptor().Fields()
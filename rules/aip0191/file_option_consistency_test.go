@@ -90,6 +90,45 @@ func TestFileOptionConsistency(t *testing.T) {
 	})
 }
 
+func TestFileOptionConsistency_RelatedLocations(t *testing.T) {
+	files := testutils.ParseProtoStrings(t, map[string]string{
+		"control.proto": `
+			syntax = "proto3";
+			package google.example.v1;
+			option java_package = "com.google.example.v1";
+		`,
+		"test.proto": `
+			syntax = "proto3";
+			package google.example.v1;
+			import "control.proto";
+			option java_package = "com.example.v1";
+		`,
+	})
+	testFile, controlFile := files["test.proto"], files["control.proto"]
+	problems := fileOptionConsistency.Lint(testFile)
+	if len(problems) != 1 {
+		t.Fatalf("Expected one problem, got %v", problems)
+	}
+	related := problems[0].RelatedLocations
+	if len(related) != 2 {
+		t.Fatalf("Expected two related locations, got %v", related)
+	}
+	for i, want := range []struct {
+		file *desc.FileDescriptor
+		line int32
+	}{
+		{testFile, 3},
+		{controlFile, 2},
+	} {
+		if related[i].Descriptor != want.file {
+			t.Errorf("Related location %d: got descriptor %v, want %v", i, related[i].Descriptor, want.file)
+		}
+		if got := related[i].Location.GetSpan()[0]; got != want.line {
+			t.Errorf("Related location %d: got line %d, want %d", i, got, want.line)
+		}
+	}
+}
+
 func getOptions(fileopts map[string]string) *dpb.FileOptions {
 	opts := &dpb.FileOptions{
 		CsharpNamespace: proto.String("Google.Example.V1"),
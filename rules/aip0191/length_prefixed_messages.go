@@ -0,0 +1,41 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aip0191
+
+import (
+	"github.com/googleapis/api-linter/lint"
+	"github.com/googleapis/api-linter/locations"
+	"github.com/googleapis/api-linter/rules/internal/utils"
+	"github.com/jhump/protoreflect/desc"
+)
+
+// Message fields in files using Protobuf Editions must use the
+// length-prefixed encoding, as in proto3.
+var lengthPrefixedMessages = &lint.FieldRule{
+	Name: lint.NewRuleName(191, "length-prefixed-messages"),
+	OnlyIf: func(f *desc.FieldDescriptor) bool {
+		return utils.IsEditions(f.GetFile()) && f.GetMessageType() != nil
+	},
+	LintField: func(f *desc.FieldDescriptor) []lint.Problem {
+		if utils.IsDelimited(f) {
+			return []lint.Problem{{
+				Message:    "API message fields must be length-prefixed; do not set `features.message_encoding = DELIMITED`.",
+				Descriptor: f,
+				Location:   locations.FieldMessageEncoding(f),
+			}}
+		}
+		return nil
+	},
+}
@@ -0,0 +1,49 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aip0191
+
+import (
+	"testing"
+
+	"github.com/googleapis/api-linter/rules/internal/testutils"
+)
+
+func TestLengthPrefixedMessages(t *testing.T) {
+	for _, test := range []struct {
+		name       string
+		FileOption string
+		Options    string
+		problems   testutils.Problems
+	}{
+		{"Valid", "", "", testutils.Problems{}},
+		{"ValidOverride", "option features.message_encoding = DELIMITED;", "[features.message_encoding = LENGTH_PREFIXED]", testutils.Problems{}},
+		{"Invalid", "", "[features.message_encoding = DELIMITED]", testutils.Problems{{Message: "length-prefixed"}}},
+		{"InvalidFileDefault", "option features.message_encoding = DELIMITED;", "", testutils.Problems{{Message: "length-prefixed"}}},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			f := testutils.ParseEditionsString(t, test.FileOption+`
+				message Book {
+					Author author = 1 `+test.Options+`;
+					string title = 2;
+				}
+				message Author {}
+			`)
+			field := f.GetMessageTypes()[0].GetFields()[0]
+			if diff := test.problems.SetDescriptor(field).Diff(lengthPrefixedMessages.Lint(f)); diff != "" {
+				t.Errorf(diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,40 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aip0191
+
+import (
+	"github.com/googleapis/api-linter/lint"
+	"github.com/googleapis/api-linter/locations"
+	"github.com/googleapis/api-linter/rules/internal/utils"
+	"github.com/jhump/protoreflect/desc"
+)
+
+// Enums in files using Protobuf Editions must be open, as in proto3.
+var openEnums = &lint.EnumRule{
+	Name: lint.NewRuleName(191, "open-enums"),
+	OnlyIf: func(e *desc.EnumDescriptor) bool {
+		return utils.IsEditions(e.GetFile())
+	},
+	LintEnum: func(e *desc.EnumDescriptor) []lint.Problem {
+		if utils.IsClosedEnum(e) {
+			return []lint.Problem{{
+				Message:    "API enums must be open; do not set `features.enum_type = CLOSED`.",
+				Descriptor: e,
+				Location:   locations.EnumType(e),
+			}}
+		}
+		return nil
+	},
+}
@@ -0,0 +1,59 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aip0191
+
+import (
+	"testing"
+
+	"github.com/googleapis/api-linter/rules/internal/testutils"
+)
+
+func TestOpenEnums(t *testing.T) {
+	for _, test := range []struct {
+		name       string
+		FileOption string
+		EnumOption string
+		problems   testutils.Problems
+	}{
+		{"Valid", "", "", testutils.Problems{}},
+		{"ValidOverride", "option features.enum_type = CLOSED;", "option features.enum_type = OPEN;", testutils.Problems{}},
+		{"Invalid", "", "option features.enum_type = CLOSED;", testutils.Problems{{Message: "must be open"}}},
+		{"InvalidFileDefault", "option features.enum_type = CLOSED;", "", testutils.Problems{{Message: "must be open"}}},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			f := testutils.ParseEditionsString(t, test.FileOption+`
+				enum Format {
+					`+test.EnumOption+`
+					FORMAT_UNSPECIFIED = 0;
+				}
+			`)
+			e := f.GetEnumTypes()[0]
+			if diff := test.problems.SetDescriptor(e).Diff(openEnums.Lint(f)); diff != "" {
+				t.Errorf(diff)
+			}
+		})
+	}
+}
+
+func TestOpenEnumsProto3(t *testing.T) {
+	f := testutils.ParseProto3String(t, `
+		enum Format {
+			FORMAT_UNSPECIFIED = 0;
+		}
+	`)
+	if diff := (testutils.Problems{}).Diff(openEnums.Lint(f)); diff != "" {
+		t.Errorf(diff)
+	}
+}
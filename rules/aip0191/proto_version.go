@@ -17,16 +17,17 @@ package aip0191
 import (
 	"github.com/googleapis/api-linter/lint"
 	"github.com/googleapis/api-linter/locations"
+	"github.com/googleapis/api-linter/rules/internal/utils"
 	"github.com/jhump/protoreflect/desc"
 )
 
-// APIs must use proto3.
+// APIs must use proto3 (or Protobuf Editions).
 var syntax = &lint.FileRule{
 	Name: lint.NewRuleName(191, "proto-version"),
 	LintFile: func(f *desc.FileDescriptor) []lint.Problem {
-		if !f.IsProto3() {
+		if !f.IsProto3() && !utils.IsEditions(f) {
 			return []lint.Problem{{
-				Message:    "All API proto files must use proto3 syntax.",
+				Message:    "All API proto files must use proto3 syntax or Protobuf Editions.",
 				Suggestion: "syntax = \"proto3\";",
 				Descriptor: f,
 				Location:   locations.FileSyntax(f),
@@ -48,3 +48,10 @@ func TestSyntax(t *testing.T) {
 		})
 	}
 }
+
+func TestSyntaxEditions(t *testing.T) {
+	f := testutils.ParseEditionsString(t, "package test;")
+	if diff := (testutils.Problems{}).Diff(syntax.Lint(f)); diff != "" {
+		t.Errorf(diff)
+	}
+}
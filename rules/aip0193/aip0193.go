@@ -0,0 +1,70 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package aip0193 contains rules defined in https://aip.dev/193.
+package aip0193
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/googleapis/api-linter/lint"
+	"github.com/googleapis/api-linter/rules/internal/utils"
+	"github.com/jhump/protoreflect/desc"
+)
+
+// AddRules adds all of the AIP-193 rules to the provided registry.
+func AddRules(r lint.RuleRegistry) error {
+	return r.Register(
+		193,
+		errorDetailsVersioned,
+		reasonDocumented,
+		reasonDomain,
+		reasonFormat,
+		reasonLength,
+		reasonUnique,
+	)
+}
+
+var (
+	reasonEnumRegexp  = regexp.MustCompile("ErrorReason$")
+	errorInfoRegexp   = regexp.MustCompile(`\b(google\.rpc\.)?ErrorInfo\b`)
+	errorDetailRegexp = regexp.MustCompile("ErrorDetails?$")
+)
+
+// Returns true if the values of this enum are used as the `reason` of a
+// `google.rpc.ErrorInfo`: it is named like `*ErrorReason`, or its comment
+// refers to `ErrorInfo`. Common protos are exempt.
+func isReasonEnum(e *desc.EnumDescriptor) bool {
+	if utils.IsCommonProto(e.GetFile()) {
+		return false
+	}
+	return reasonEnumRegexp.MatchString(e.GetName()) || errorInfoRegexp.MatchString(externalComment(e))
+}
+
+// reasons returns the values of a reason enum that are reasons, which are all
+// but the zero value.
+func reasons(e *desc.EnumDescriptor) []*desc.EnumValueDescriptor {
+	var values []*desc.EnumValueDescriptor
+	for _, v := range e.GetValues() {
+		if v.GetNumber() != 0 {
+			values = append(values, v)
+		}
+	}
+	return values
+}
+
+func externalComment(d desc.Descriptor) string {
+	return strings.Join(utils.SeparateInternalComments(d.GetSourceInfo().GetLeadingComments()).External, "\n")
+}
@@ -0,0 +1,58 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aip0193
+
+import (
+	"testing"
+
+	"github.com/googleapis/api-linter/lint"
+	"github.com/googleapis/api-linter/rules/internal/testutils"
+)
+
+func TestAddRules(t *testing.T) {
+	if err := AddRules(lint.NewRuleRegistry()); err != nil {
+		t.Errorf("AddRules got an error: %v", err)
+	}
+}
+
+func TestIsReasonEnum(t *testing.T) {
+	for _, test := range []struct {
+		name    string
+		Package string
+		Comment string
+		Enum    string
+		want    bool
+	}{
+		{"Name", "google.example.v1", "Reasons.", "ErrorReason", true},
+		{"NameSuffix", "google.example.v1", "Reasons.", "LibraryErrorReason", true},
+		{"Comment", "google.example.v1", "The reason of a google.rpc.ErrorInfo.", "Cause", true},
+		{"Other", "google.example.v1", "A state.", "State", false},
+		{"CommonProto", "google.api", "Reasons.", "ErrorReason", false},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			f := testutils.ParseProto3Tmpl(t, `
+				package {{.Package}};
+
+				// {{.Comment}}
+				enum {{.Enum}} {
+					{{.Enum}}_UNSPECIFIED = 0;
+				}
+			`, test)
+			if got := isReasonEnum(f.GetEnumTypes()[0]); got != test.want {
+				t.Errorf("isReasonEnum() = %t; want %t", got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,41 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aip0193
+
+import (
+	"github.com/googleapis/api-linter/lint"
+	"github.com/googleapis/api-linter/locations"
+	"github.com/googleapis/api-linter/rules/internal/utils"
+	"github.com/jhump/protoreflect/desc"
+)
+
+// Error detail messages are part of the API surface, so they belong in a
+// versioned package like the rest of it.
+var errorDetailsVersioned = &lint.MessageRule{
+	Name: lint.NewRuleName(193, "error-details-versioned"),
+	OnlyIf: func(m *desc.MessageDescriptor) bool {
+		return errorDetailRegexp.MatchString(m.GetName()) && !utils.IsCommonProto(m.GetFile())
+	},
+	LintMessage: func(m *desc.MessageDescriptor) []lint.Problem {
+		if !utils.IsVersionedPackage(m.GetFile().GetPackage()) {
+			return []lint.Problem{{
+				Message:    "Error detail messages should be in a versioned package, such as `google.example.library.v1`.",
+				Descriptor: m,
+				Location:   locations.FilePackage(m.GetFile()),
+			}}
+		}
+		return nil
+	},
+}
@@ -0,0 +1,49 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aip0193
+
+import (
+	"testing"
+
+	"github.com/googleapis/api-linter/rules/internal/testutils"
+)
+
+func TestErrorDetailsVersioned(t *testing.T) {
+	for _, test := range []struct {
+		name     string
+		Package  string
+		Message  string
+		problems testutils.Problems
+	}{
+		{"Valid", "google.example.library.v1", "QuotaErrorDetails", nil},
+		{"ValidBeta", "google.example.library.v1beta1", "QuotaErrorDetail", nil},
+		{"Unversioned", "google.example.library", "QuotaErrorDetails", testutils.Problems{{Message: "versioned package"}}},
+		{"VersionPrefix", "google.example.library.v1x", "QuotaErrorDetails", testutils.Problems{{Message: "versioned package"}}},
+		{"CommonProto", "google.rpc", "QuotaErrorDetails", nil},
+		{"IrrelevantMessage", "google.example.library", "Book", nil},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			f := testutils.ParseProto3Tmpl(t, `
+				package {{.Package}};
+
+				message {{.Message}} {}
+			`, test)
+			m := f.GetMessageTypes()[0]
+			if diff := test.problems.SetDescriptor(m).Diff(errorDetailsVersioned.Lint(f)); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
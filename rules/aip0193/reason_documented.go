@@ -0,0 +1,42 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aip0193
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/googleapis/api-linter/lint"
+	"github.com/googleapis/api-linter/locations"
+	"github.com/jhump/protoreflect/desc"
+)
+
+var reasonDocumented = &lint.EnumRule{
+	Name:   lint.NewRuleName(193, "reason-documented"),
+	OnlyIf: isReasonEnum,
+	LintEnum: func(e *desc.EnumDescriptor) []lint.Problem {
+		var problems []lint.Problem
+		for _, v := range reasons(e) {
+			if strings.TrimSpace(externalComment(v)) == "" {
+				problems = append(problems, lint.Problem{
+					Message:    fmt.Sprintf("Error reason %q should have a comment explaining when it is returned.", v.GetName()),
+					Descriptor: v,
+					Location:   locations.DescriptorName(v),
+				})
+			}
+		}
+		return problems
+	},
+}
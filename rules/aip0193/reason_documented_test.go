@@ -0,0 +1,52 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aip0193
+
+import (
+	"testing"
+
+	"github.com/googleapis/api-linter/rules/internal/testutils"
+)
+
+func TestReasonDocumented(t *testing.T) {
+	for _, test := range []struct {
+		name     string
+		Enum     string
+		Comment  string
+		problems testutils.Problems
+	}{
+		{"Valid", "ErrorReason", "// The book is checked out.", nil},
+		{"Missing", "ErrorReason", "", testutils.Problems{{Message: "should have a comment"}}},
+		{"InternalOnly", "ErrorReason", "// (-- Internal. --)", testutils.Problems{{Message: "should have a comment"}}},
+		{"IrrelevantEnum", "State", "", nil},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			f := testutils.ParseProto3Tmpl(t, `
+				package google.example.v1;
+
+				enum {{.Enum}} {
+					UNSPECIFIED = 0;
+
+					{{.Comment}}
+					BOOK_NOT_AVAILABLE = 1;
+				}
+			`, test)
+			v := f.GetEnumTypes()[0].GetValues()[1]
+			if diff := test.problems.SetDescriptor(v).Diff(reasonDocumented.Lint(f)); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aip0193
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"bitbucket.org/creachadair/stringset"
+	"github.com/googleapis/api-linter/lint"
+	"github.com/googleapis/api-linter/rules/internal/utils"
+	"github.com/jhump/protoreflect/desc"
+	apb "google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/protobuf/proto"
+)
+
+// The reasons of a reason enum belong to an `ErrorInfo.domain`, which should
+// be the service name: the name of the service config, or the
+// `google.api.default_host` of the file's service. An enum may also document
+// its domain in its comment.
+var reasonDomain = lint.NewFileSetRule(func(set *lint.FileSet) lint.ProtoRule {
+	return &lint.EnumRule{
+		Name:   lint.NewRuleName(193, "reason-domain"),
+		OnlyIf: isReasonEnum,
+		LintEnum: func(e *desc.EnumDescriptor) []lint.Problem {
+			names := serviceNames(e.GetFile(), set)
+			domain := documentedDomain(e)
+			if domain == "" {
+				if names.Len() == 1 {
+					return nil
+				}
+				return []lint.Problem{{
+					Message:    "Error reason enums should document the `ErrorInfo.domain` of their reasons, such as `domain \"library.googleapis.com\"`, when the file does not have exactly one service name.",
+					Descriptor: e,
+				}}
+			}
+			if names.Len() > 0 && !names.Contains(strings.ToLower(domain)) {
+				return []lint.Problem{{
+					Message: fmt.Sprintf(
+						"The error domain %q should be the service name, one of %s.",
+						domain, strings.Join(names.Elements(), ", "),
+					),
+					Descriptor: e,
+				}}
+			}
+			return nil
+		},
+	}
+})
+
+// domainRegexp matches a quoted domain, such as "library.googleapis.com",
+// after the word "domain".
+var domainRegexp = regexp.MustCompile("(?i)\\bdomain(?:\\s+is)?:?\\s+[\"`]([a-z0-9-]+(?:\\.[a-z0-9-]+)+)[\"`]")
+
+// documentedDomain returns the domain documented in the comment of a reason
+// enum, or an empty string if there is none.
+func documentedDomain(e *desc.EnumDescriptor) string {
+	if match := domainRegexp.FindStringSubmatch(externalComment(e)); match != nil {
+		return match[1]
+	}
+	return ""
+}
+
+// errorDomain returns the `ErrorInfo.domain` of the reasons of a reason enum:
+// the domain documented in its comment, or else the service name, if the file
+// has exactly one. It returns an empty string otherwise.
+func errorDomain(e *desc.EnumDescriptor, set *lint.FileSet) string {
+	if domain := documentedDomain(e); domain != "" {
+		return strings.ToLower(domain)
+	}
+	if names := serviceNames(e.GetFile(), set); names.Len() == 1 {
+		return names.Elements()[0]
+	}
+	return ""
+}
+
+// serviceNames returns the names that the service of a file goes by: the name
+// of the service config, if any, and the `google.api.default_host` of the
+// file's services, without ports.
+func serviceNames(f *desc.FileDescriptor, set *lint.FileSet) stringset.Set {
+	names := stringset.New()
+	if name := utils.GetServiceConfig(set).GetName(); name != "" {
+		names.Add(strings.ToLower(name))
+	}
+	for _, s := range f.GetServices() {
+		if host, ok := proto.GetExtension(s.GetServiceOptions(), apb.E_DefaultHost).(string); ok && host != "" {
+			names.Add(strings.ToLower(strings.SplitN(host, ":", 2)[0]))
+		}
+	}
+	return names
+}
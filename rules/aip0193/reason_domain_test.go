@@ -0,0 +1,103 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aip0193
+
+import (
+	"testing"
+
+	"github.com/googleapis/api-linter/lint"
+	"github.com/googleapis/api-linter/rules/internal/testutils"
+	"google.golang.org/genproto/googleapis/api/serviceconfig"
+)
+
+func TestReasonDomain(t *testing.T) {
+	for _, test := range []struct {
+		name     string
+		Comment  string
+		Host     string
+		problems testutils.Problems
+	}{
+		{"Valid", "Reasons for errors in the domain \"library.googleapis.com\".", "library.googleapis.com", nil},
+		{"ValidPort", "The domain is \"library.googleapis.com\".", "library.googleapis.com:443", nil},
+		{"ValidNoService", "Errors of domain `library.googleapis.com`.", "", nil},
+		{"ValidServiceName", "Reasons for errors.", "library.googleapis.com", nil},
+		{"Missing", "Reasons for errors.", "", testutils.Problems{{Message: "should document the `ErrorInfo.domain`"}}},
+		{"Unquoted", "The domain is library.googleapis.com.", "", testutils.Problems{{Message: "should document the `ErrorInfo.domain`"}}},
+		{"Mismatch", "The domain is \"shelf.googleapis.com\".", "library.googleapis.com", testutils.Problems{{Message: "one of library.googleapis.com"}}},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			f := testutils.ParseProto3Tmpl(t, `
+				package google.example.v1;
+
+				import "google/api/client.proto";
+
+				service Library {
+					{{if .Host}}option (google.api.default_host) = "{{.Host}}";{{end}}
+				}
+
+				// {{.Comment}}
+				enum ErrorReason {
+					ERROR_REASON_UNSPECIFIED = 0;
+				}
+			`, test)
+			e := f.GetEnumTypes()[0]
+			if diff := test.problems.SetDescriptor(e).Diff(reasonDomain.Lint(f)); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestReasonDomainServiceConfig(t *testing.T) {
+	f := testutils.ParseProto3String(t, `
+		package google.example.v1;
+
+		// The domain is "shelf.googleapis.com".
+		enum ShelfErrorReason {
+			SHELF_ERROR_REASON_UNSPECIFIED = 0;
+		}
+
+		// Reasons for errors.
+		enum ErrorReason {
+			ERROR_REASON_UNSPECIFIED = 0;
+		}
+	`)
+	for _, test := range []struct {
+		name     string
+		cfg      *serviceconfig.Service
+		problems testutils.Problems
+	}{
+		{"None", nil, testutils.Problems{
+			{Message: "should document the `ErrorInfo.domain`", Descriptor: f.GetEnumTypes()[1]},
+		}},
+		{"Named", &serviceconfig.Service{Name: "library.googleapis.com"}, testutils.Problems{
+			{Message: "one of library.googleapis.com", Descriptor: f.GetEnumTypes()[0]},
+		}},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			registry := lint.NewRuleRegistry()
+			if err := registry.Register(193, reasonDomain); err != nil {
+				t.Fatal(err)
+			}
+			resps, err := lint.New(registry, nil, lint.ServiceConfig(test.cfg)).LintProtos(f)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := test.problems.Diff(resps[0].Problems); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,50 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aip0193
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/googleapis/api-linter/lint"
+	"github.com/googleapis/api-linter/locations"
+	"github.com/jhump/protoreflect/desc"
+	"github.com/stoewer/go-strcase"
+)
+
+var reasonFormat = &lint.EnumRule{
+	Name:   lint.NewRuleName(193, "reason-format"),
+	OnlyIf: isReasonEnum,
+	LintEnum: func(e *desc.EnumDescriptor) []lint.Problem {
+		var problems []lint.Problem
+		for _, v := range reasons(e) {
+			if !reasonRegexp.MatchString(v.GetName()) {
+				problem := lint.Problem{
+					Message:    fmt.Sprintf("Error reason %q must be UPPER_SNAKE_CASE, matching %s.", v.GetName(), reasonRegexp),
+					Descriptor: v,
+					Location:   locations.DescriptorName(v),
+				}
+				if want := strings.ToUpper(strcase.SnakeCase(v.GetName())); reasonRegexp.MatchString(want) {
+					problem.Suggestion = want
+				}
+				problems = append(problems, problem)
+			}
+		}
+		return problems
+	},
+}
+
+var reasonRegexp = regexp.MustCompile(`^[A-Z][A-Z0-9_]*[A-Z0-9]$`)
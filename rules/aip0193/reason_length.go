@@ -0,0 +1,43 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aip0193
+
+import (
+	"fmt"
+
+	"github.com/googleapis/api-linter/lint"
+	"github.com/googleapis/api-linter/locations"
+	"github.com/jhump/protoreflect/desc"
+)
+
+const maxReasonLength = 63
+
+var reasonLength = &lint.EnumRule{
+	Name:   lint.NewRuleName(193, "reason-length"),
+	OnlyIf: isReasonEnum,
+	LintEnum: func(e *desc.EnumDescriptor) []lint.Problem {
+		var problems []lint.Problem
+		for _, v := range reasons(e) {
+			if n := len(v.GetName()); n > maxReasonLength {
+				problems = append(problems, lint.Problem{
+					Message:    fmt.Sprintf("Error reason %q has %d characters; it must be at most %d.", v.GetName(), n, maxReasonLength),
+					Descriptor: v,
+					Location:   locations.DescriptorName(v),
+				})
+			}
+		}
+		return problems
+	},
+}
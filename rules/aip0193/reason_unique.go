@@ -0,0 +1,137 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aip0193
+
+import (
+	"fmt"
+
+	"github.com/googleapis/api-linter/lint"
+	"github.com/googleapis/api-linter/locations"
+	"github.com/jhump/protoreflect/desc"
+)
+
+// Error reasons must be unique within their domain, so the reason enums of the
+// same domain must not share values, in any file of the lint run. The reasons
+// of an enum without a known domain are only compared with the other reasons
+// of its file.
+var reasonUnique = lint.NewFileSetRule(func(set *lint.FileSet) lint.ProtoRule {
+	return &lint.FileRule{
+		Name: lint.NewRuleName(193, "reason-unique"),
+		LintFile: func(f *desc.FileDescriptor) []lint.Problem {
+			index := reasonIndexOf(f, set)
+			var problems []lint.Problem
+			for _, e := range allEnums(f) {
+				if !isReasonEnum(e) {
+					continue
+				}
+				key := reasonScopeOf(e, set)
+				for _, v := range reasons(e) {
+					first := firstDuplicate(index[key][v.GetName()], v)
+					if first == nil {
+						continue
+					}
+					where := fmt.Sprintf("%q", first.GetEnum().GetName())
+					if first.GetFile() != v.GetFile() {
+						where = fmt.Sprintf("%q of %s", first.GetEnum().GetFullyQualifiedName(), first.GetFile().GetName())
+					}
+					problems = append(problems, lint.Problem{
+						Message:    fmt.Sprintf("Error reason %q is already defined in %s; reasons must be unique within their domain.", v.GetName(), where),
+						Descriptor: v,
+						Location:   locations.DescriptorName(v),
+					})
+				}
+			}
+			return problems
+		},
+	}
+})
+
+// reasonScope identifies the values that reasons must be unique among: the
+// error domain, or the file for enums without one.
+type reasonScope struct {
+	domain string
+	file   string
+}
+
+func reasonScopeOf(e *desc.EnumDescriptor, set *lint.FileSet) reasonScope {
+	if domain := errorDomain(e, set); domain != "" {
+		return reasonScope{domain: domain}
+	}
+	return reasonScope{file: e.GetFile().GetName()}
+}
+
+// reasonIndex maps each scope and reason name to the values defining it, in
+// the order of the files and of the enums within each file.
+type reasonIndex map[reasonScope]map[string][]*desc.EnumValueDescriptor
+
+// reasonIndexKey is the key of the reasonIndex in a lint.FileSet.
+type reasonIndexKey struct{}
+
+// reasonIndexOf returns the reasons of every file of the lint run's FileSet,
+// or of the given file if the set is nil.
+func reasonIndexOf(f *desc.FileDescriptor, set *lint.FileSet) reasonIndex {
+	if set != nil {
+		return set.Value(reasonIndexKey{}, func(files []*desc.FileDescriptor) interface{} {
+			return newReasonIndex(set, files...)
+		}).(reasonIndex)
+	}
+	return newReasonIndex(nil, f)
+}
+
+func newReasonIndex(set *lint.FileSet, files ...*desc.FileDescriptor) reasonIndex {
+	index := reasonIndex{}
+	for _, f := range files {
+		for _, e := range allEnums(f) {
+			if !isReasonEnum(e) {
+				continue
+			}
+			key := reasonScopeOf(e, set)
+			if index[key] == nil {
+				index[key] = map[string][]*desc.EnumValueDescriptor{}
+			}
+			for _, v := range reasons(e) {
+				index[key][v.GetName()] = append(index[key][v.GetName()], v)
+			}
+		}
+	}
+	return index
+}
+
+// firstDuplicate returns the first of the values that v duplicates: a value
+// of another file, or one that comes before v in its file. Only the later
+// values of a file are reported, while values of different files are reported
+// in each of them.
+func firstDuplicate(values []*desc.EnumValueDescriptor, v *desc.EnumValueDescriptor) *desc.EnumValueDescriptor {
+	before := true
+	for _, other := range values {
+		if other == v {
+			before = false
+			continue
+		}
+		if before || other.GetFile() != v.GetFile() {
+			return other
+		}
+	}
+	return nil
+}
+
+// allEnums returns the enums of a file, including nested enums.
+func allEnums(f *desc.FileDescriptor) []*desc.EnumDescriptor {
+	enums := append([]*desc.EnumDescriptor{}, f.GetEnumTypes()...)
+	for _, m := range lint.GetAllMessages(f) {
+		enums = append(enums, m.GetNestedEnumTypes()...)
+	}
+	return enums
+}
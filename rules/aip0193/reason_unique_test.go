@@ -0,0 +1,188 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aip0193
+
+import (
+	"testing"
+
+	"github.com/googleapis/api-linter/lint"
+	"github.com/googleapis/api-linter/rules/internal/testutils"
+)
+
+func TestReasonUnique(t *testing.T) {
+	for _, test := range []struct {
+		name     string
+		Enum     string
+		Value    string
+		problems testutils.Problems
+	}{
+		{"Valid", "ShelfErrorReason", "SHELF_FULL", nil},
+		{"Duplicate", "ShelfErrorReason", "BOOK_NOT_AVAILABLE", testutils.Problems{{Message: "already defined in \"BookErrorReason\""}}},
+		{"IrrelevantEnum", "State", "BOOK_NOT_AVAILABLE", nil},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			f := testutils.ParseProto3Tmpl(t, `
+				package google.example.v1;
+
+				enum BookErrorReason {
+					BOOK_ERROR_REASON_UNSPECIFIED = 0;
+					BOOK_NOT_AVAILABLE = 1;
+				}
+
+				message Shelf {
+					enum {{.Enum}} {
+						UNSPECIFIED = 0;
+						{{.Value}} = 1;
+					}
+				}
+			`, test)
+			v := f.GetMessageTypes()[0].GetNestedEnumTypes()[0].GetValues()[1]
+			if diff := test.problems.SetDescriptor(v).Diff(reasonUnique.Lint(f)); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestReasonUniqueDomains(t *testing.T) {
+	for _, test := range []struct {
+		name     string
+		Domain   string
+		problems testutils.Problems
+	}{
+		{"SameDomain", "library.googleapis.com", testutils.Problems{{Message: "already defined in \"BookErrorReason\""}}},
+		{"SameDomainCase", "Library.googleapis.com", testutils.Problems{{Message: "already defined in \"BookErrorReason\""}}},
+		{"OtherDomain", "shelves.googleapis.com", nil},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			f := testutils.ParseProto3Tmpl(t, `
+				package google.example.v1;
+
+				// The domain is "library.googleapis.com".
+				enum BookErrorReason {
+					BOOK_ERROR_REASON_UNSPECIFIED = 0;
+					NOT_AVAILABLE = 1;
+				}
+
+				message Shelf {
+					// The domain is "{{.Domain}}".
+					enum ErrorReason {
+						ERROR_REASON_UNSPECIFIED = 0;
+						NOT_AVAILABLE = 1;
+					}
+				}
+			`, test)
+			v := f.GetMessageTypes()[0].GetNestedEnumTypes()[0].GetValues()[1]
+			if diff := test.problems.SetDescriptor(v).Diff(reasonUnique.Lint(f)); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestReasonUniqueAcrossFiles(t *testing.T) {
+	files := testutils.ParseProtoStrings(t, map[string]string{
+		"books.proto": `
+			syntax = "proto3";
+			package google.example.v1;
+
+			// The domain is "library.googleapis.com".
+			enum BookErrorReason {
+				BOOK_ERROR_REASON_UNSPECIFIED = 0;
+				NOT_AVAILABLE = 1;
+				BOOK_LOST = 2;
+			}
+		`,
+		"shelves.proto": `
+			syntax = "proto3";
+			package google.example.shelves.v1;
+
+			// The domain is "library.googleapis.com".
+			enum ShelfErrorReason {
+				SHELF_ERROR_REASON_UNSPECIFIED = 0;
+				NOT_AVAILABLE = 1;
+			}
+
+			// These reasons have no documented domain, so they are only
+			// compared with the reasons of this file.
+			message Location {
+				enum ErrorReason {
+					ERROR_REASON_UNSPECIFIED = 0;
+					BOOK_LOST = 1;
+				}
+			}
+		`,
+	})
+	registry := lint.NewRuleRegistry()
+	if err := registry.Register(193, reasonUnique); err != nil {
+		t.Fatal(err)
+	}
+	resps, err := lint.New(registry, nil).LintProtos(files["books.proto"], files["shelves.proto"])
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Duplicates across files are reported in each file.
+	want := []testutils.Problems{
+		{{Message: "\"google.example.shelves.v1.ShelfErrorReason\" of shelves.proto", Descriptor: files["books.proto"].GetEnumTypes()[0].GetValues()[1]}},
+		{{Message: "\"google.example.v1.BookErrorReason\" of books.proto", Descriptor: files["shelves.proto"].GetEnumTypes()[0].GetValues()[1]}},
+	}
+	for i, resp := range resps {
+		if diff := want[i].Diff(resp.Problems); diff != "" {
+			t.Errorf("%s: %s", resp.FilePath, diff)
+		}
+	}
+}
+
+func TestReasonUniqueServiceName(t *testing.T) {
+	file := func(pkg, enum string) string {
+		return `
+			syntax = "proto3";
+			package ` + pkg + `;
+
+			import "google/api/client.proto";
+
+			service Library {
+				option (google.api.default_host) = "library.googleapis.com";
+			}
+
+			enum ` + enum + ` {
+				ERROR_REASON_UNSPECIFIED = 0;
+				NOT_AVAILABLE = 1;
+			}
+		`
+	}
+	files := testutils.ParseProtoStrings(t, map[string]string{
+		"books.proto":   file("google.example.v1", "BookErrorReason"),
+		"shelves.proto": file("google.example.shelves.v1", "ShelfErrorReason"),
+	})
+	registry := lint.NewRuleRegistry()
+	if err := registry.Register(193, reasonUnique); err != nil {
+		t.Fatal(err)
+	}
+	resps, err := lint.New(registry, nil).LintProtos(files["books.proto"], files["shelves.proto"])
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Enums without a documented domain belong to the domain of the service.
+	want := []testutils.Problems{
+		{{Message: "\"google.example.shelves.v1.ShelfErrorReason\" of shelves.proto", Descriptor: files["books.proto"].GetEnumTypes()[0].GetValues()[1]}},
+		{{Message: "\"google.example.v1.BookErrorReason\" of books.proto", Descriptor: files["shelves.proto"].GetEnumTypes()[0].GetValues()[1]}},
+	}
+	for i, resp := range resps {
+		if diff := want[i].Diff(resp.Problems); diff != "" {
+			t.Errorf("%s: %s", resp.FilePath, diff)
+		}
+	}
+}
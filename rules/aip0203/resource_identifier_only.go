@@ -34,6 +34,7 @@ var resourceIdentifierOnly = &lint.FieldRule{
 		return []lint.Problem{{
 			Message:    "The IDENTIFIER `google.api.field_behavior` annotation must only be applied to a resource's name field.",
 			Descriptor: f,
+			Location:   utils.FieldBehaviorLocation(f, "IDENTIFIER"),
 		}}
 	},
 }
@@ -32,6 +32,7 @@ var unorderedListRepeated = &lint.FieldRule{
 		return []lint.Problem{{
 			Message:    "The UNORDERED_LIST `google.api.field_behavior` annotation must not be applied to non-repeated fields.",
 			Descriptor: f,
+			Location:   utils.FieldBehaviorLocation(f, "UNORDERED_LIST"),
 		}}
 	},
 }
@@ -0,0 +1,320 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aip0213
+
+import (
+	"fmt"
+	"strings"
+
+	"bitbucket.org/creachadair/stringset"
+	"github.com/googleapis/api-linter/lint"
+	"github.com/googleapis/api-linter/locations"
+	"github.com/googleapis/api-linter/rules/internal/utils"
+	"github.com/jhump/protoreflect/desc"
+)
+
+var commonTypes = &commonTypesRule{ProtoRule: newCommonTypes(components)}
+
+// commonTypesRule checks the catalog of components. It accepts the following
+// options:
+//
+//   - ignored_types: the common types, such as "google.type.Color", that are
+//     not suggested.
+//   - components: components to check in addition to the catalog, such as the
+//     common types of an organization.
+type commonTypesRule struct {
+	lint.ProtoRule
+}
+
+// componentOption is a component given with the components option.
+type componentOption struct {
+	Type      string                 `json:"type"`
+	Import    string                 `json:"import"`
+	FieldName string                 `json:"field_name"`
+	Groups    [][]fieldPatternOption `json:"groups"`
+}
+
+// fieldPatternOption is a field pattern of a componentOption.
+type fieldPatternOption struct {
+	Name  string   `json:"name"`
+	Types []string `json:"types"`
+}
+
+// WithOptions returns the rule checking the components that are not ignored,
+// and the ones given as options.
+func (r *commonTypesRule) WithOptions(opts lint.RuleOptions) (lint.ProtoRule, error) {
+	for key := range opts {
+		if key != "ignored_types" && key != "components" {
+			return nil, fmt.Errorf("unknown option %q", key)
+		}
+	}
+	ignored, err := opts.StringSlice("ignored_types")
+	if err != nil {
+		return nil, err
+	}
+	var options []componentOption
+	if err := opts.Decode("components", &options); err != nil {
+		return nil, err
+	}
+	unknown := stringset.New(ignored...)
+	var kept []component
+	for _, c := range components {
+		unknown.Discard(c.typeName)
+		if !stringset.Contains(ignored, c.typeName) {
+			kept = append(kept, c)
+		}
+	}
+	if unknown.Len() > 0 {
+		return nil, fmt.Errorf("unknown common types %s", strings.Join(unknown.Elements(), ", "))
+	}
+	for i, o := range options {
+		c, err := o.component()
+		if err != nil {
+			return nil, fmt.Errorf("component %d: %w", i, err)
+		}
+		kept = append(kept, c)
+	}
+	return newCommonTypes(kept), nil
+}
+
+// component returns the component, or an error if the option is incomplete.
+func (o componentOption) component() (component, error) {
+	if !strings.Contains(o.Type, ".") {
+		return component{}, fmt.Errorf("type %q must be a full name, such as \"acme.type.Distance\"", o.Type)
+	}
+	if o.Import == "" {
+		return component{}, fmt.Errorf("%s has no import", o.Type)
+	}
+	if len(o.Groups) == 0 {
+		return component{}, fmt.Errorf("%s has no groups", o.Type)
+	}
+	c := component{typeName: o.Type, importPath: o.Import, fieldName: o.FieldName}
+	for _, group := range o.Groups {
+		if len(group) == 0 {
+			return component{}, fmt.Errorf("%s has an empty group", o.Type)
+		}
+		var patterns []fieldPattern
+		for _, p := range group {
+			if p.Name == "" || len(p.Types) == 0 {
+				return component{}, fmt.Errorf("%s has a field without a name or types", o.Type)
+			}
+			patterns = append(patterns, fieldPattern{p.Name, stringset.New(p.Types...)})
+		}
+		c.groups = append(c.groups, patterns)
+	}
+	return c, nil
+}
+
+func newCommonTypes(components []component) lint.ProtoRule {
+	return &lint.MessageRule{
+		Name: lint.NewRuleName(213, "common-types"),
+		LintMessage: func(m *desc.MessageDescriptor) []lint.Problem {
+			var problems []lint.Problem
+			for _, c := range components {
+				// The package of the common type is exempt.
+				if c.definedIn(m.GetFile().GetPackage()) {
+					continue
+				}
+				for _, fields := range c.match(m) {
+					problems = append(problems, c.problem(m, fields))
+				}
+			}
+			return problems
+		},
+	}
+}
+
+// component is a common component, and the fields that hand-roll it.
+type component struct {
+	// typeName is the full name of the common type, such as
+	// "google.type.Money".
+	typeName string
+
+	// importPath is the file that defines the common type.
+	importPath string
+
+	// fieldName is the name of a field of the common type, such as "color".
+	// A single hand-rolled field is only suggested to change type if it
+	// already has this name.
+	fieldName string
+
+	// groups are the fields that make up the component. A message hand-rolls
+	// the component if, for every group, one of its fields has the name and
+	// type of one of the group's patterns. The fields may share a prefix, such
+	// as "price_" in "price_currency_code" and "price_units".
+	groups [][]fieldPattern
+}
+
+// fieldPattern matches a field by name (after the prefix) and type.
+type fieldPattern struct {
+	name  string
+	types stringset.Set
+}
+
+var (
+	stringType  = stringset.New("string")
+	integerType = stringset.New("int32", "int64", "uint32", "uint64", "sint32", "sint64", "fixed32", "fixed64", "sfixed32", "sfixed64")
+	floatType   = stringset.New("double", "float")
+	numberType  = integerType.Union(floatType)
+)
+
+// components is the catalog of common components that messages should use
+// rather than hand-roll. New components only need an entry here; users may
+// ignore some of them with the ignored_types option, and add their own with the
+// components option.
+var components = []component{
+	{
+		typeName:   "google.type.Money",
+		importPath: "google/type/money.proto",
+		groups: [][]fieldPattern{
+			{{"currency_code", stringType}},
+			{{"units", integerType}, {"nanos", integerType}, {"amount", numberType}, {"amount_micros", integerType}},
+		},
+	},
+	{
+		typeName:   "google.type.LatLng",
+		importPath: "google/type/latlng.proto",
+		groups: [][]fieldPattern{
+			{{"latitude", floatType}},
+			{{"longitude", floatType}},
+		},
+	},
+	{
+		typeName:   "google.type.Color",
+		importPath: "google/type/color.proto",
+		groups: [][]fieldPattern{
+			{{"red", numberType}},
+			{{"green", numberType}},
+			{{"blue", numberType}},
+		},
+	},
+	{
+		typeName:   "google.type.Color",
+		importPath: "google/type/color.proto",
+		fieldName:  "color",
+		groups: [][]fieldPattern{
+			{{"color", stringType.Union(integerType)}},
+		},
+	},
+	{
+		typeName:   "google.type.PostalAddress",
+		importPath: "google/type/postal_address.proto",
+		fieldName:  "address",
+		groups: [][]fieldPattern{
+			{{"address_lines", stringType}, {"address_line", stringType}, {"address_line_1", stringType}, {"address_line1", stringType}},
+		},
+	},
+	{
+		typeName:   "google.type.Date",
+		importPath: "google/type/date.proto",
+		fieldName:  "date",
+		groups: [][]fieldPattern{
+			{{"date", stringType}},
+		},
+	},
+}
+
+// definedIn returns true if the package is the package of the common type, or
+// is nested in it.
+func (c component) definedIn(pkg string) bool {
+	parent := c.typeName[:strings.LastIndex(c.typeName, ".")]
+	return pkg == parent || strings.HasPrefix(pkg, parent+".")
+}
+
+// match returns the sets of fields of the message that hand-roll the
+// component, one for each prefix.
+func (c component) match(m *desc.MessageDescriptor) [][]*desc.FieldDescriptor {
+	var matches [][]*desc.FieldDescriptor
+	seen := stringset.New()
+	for _, f := range m.GetFields() {
+		for _, p := range c.groups[0] {
+			prefix, ok := p.prefix(f)
+			if !ok || seen.Contains(prefix) {
+				continue
+			}
+			seen.Add(prefix)
+			if fields := c.matchPrefix(m, prefix); fields != nil {
+				matches = append(matches, fields)
+			}
+		}
+	}
+	return matches
+}
+
+// matchPrefix returns the fields with the given prefix that hand-roll the
+// component, or nil.
+func (c component) matchPrefix(m *desc.MessageDescriptor, prefix string) []*desc.FieldDescriptor {
+	var fields []*desc.FieldDescriptor
+	for _, group := range c.groups {
+		found := false
+		for _, p := range group {
+			if f := m.FindFieldByName(prefix + p.name); f != nil && p.types.Contains(utils.GetTypeName(f)) {
+				fields = append(fields, f)
+				found = true
+			}
+		}
+		if !found {
+			return nil
+		}
+	}
+	return fields
+}
+
+// prefix returns the prefix of the field's name if the field matches the
+// pattern.
+func (p fieldPattern) prefix(f *desc.FieldDescriptor) (string, bool) {
+	name := f.GetName()
+	if !p.types.Contains(utils.GetTypeName(f)) {
+		return "", false
+	}
+	if name == p.name {
+		return "", true
+	}
+	if strings.HasSuffix(name, "_"+p.name) {
+		return strings.TrimSuffix(name, p.name), true
+	}
+	return "", false
+}
+
+func (c component) problem(m *desc.MessageDescriptor, fields []*desc.FieldDescriptor) lint.Problem {
+	if len(fields) == 1 {
+		f := fields[0]
+		problem := lint.Problem{
+			Message: fmt.Sprintf(
+				"Field `%s` looks like a hand-rolled `%s`; use `%s` instead, importing %q.",
+				f.GetName(), c.typeName, c.typeName, c.importPath,
+			),
+			Descriptor: f,
+		}
+		// Only suggest changing the type if the field can keep its label and
+		// name, such as `string color` becoming `google.type.Color color`.
+		if !f.IsRepeated() && (f.GetName() == c.fieldName || strings.HasSuffix(f.GetName(), "_"+c.fieldName)) {
+			problem.Suggestion = c.typeName
+			problem.Location = locations.FieldType(f)
+		}
+		return problem
+	}
+	names := make([]string, 0, len(fields))
+	for _, f := range fields {
+		names = append(names, fmt.Sprintf("`%s`", f.GetName()))
+	}
+	return lint.Problem{
+		Message: fmt.Sprintf(
+			"Fields %s look like a hand-rolled `%s`; use a single `%s` field instead, importing %q.",
+			strings.Join(names, ", "), c.typeName, c.typeName, c.importPath,
+		),
+		Descriptor: m,
+	}
+}
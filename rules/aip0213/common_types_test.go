@@ -0,0 +1,183 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aip0213
+
+import (
+	"testing"
+
+	"github.com/googleapis/api-linter/lint"
+	"github.com/googleapis/api-linter/rules/internal/testutils"
+	"github.com/jhump/protoreflect/desc"
+)
+
+func TestCommonTypes(t *testing.T) {
+	for _, test := range []struct {
+		name     string
+		Package  string
+		Fields   string
+		field    bool
+		problems testutils.Problems
+	}{
+		{"Money", "test", "string currency_code = 1; int64 units = 2; int32 nanos = 3;", false,
+			testutils.Problems{{Message: "`currency_code`, `units`, `nanos` look like a hand-rolled `google.type.Money`; use a single `google.type.Money` field instead, importing \"google/type/money.proto\"."}}},
+		{"MoneyPrefix", "test", "string price_currency_code = 1; double price_amount = 2;", false,
+			testutils.Problems{{Message: "google/type/money.proto"}}},
+		{"MoneyTwice", "test", "string price_currency_code = 1; int64 price_units = 2; string fee_currency_code = 3; int64 fee_units = 4;", false,
+			testutils.Problems{{Message: "`price_units`"}, {Message: "`fee_units`"}}},
+		{"CurrencyCodeOnly", "test", "string currency_code = 1;", false, nil},
+		{"MismatchedPrefix", "test", "string price_currency_code = 1; int64 units = 2;", false, nil},
+		{"LatLng", "test", "double latitude = 1; double longitude = 2;", false,
+			testutils.Problems{{Message: "google/type/latlng.proto"}}},
+		{"LatLngWrongType", "test", "string latitude = 1; string longitude = 2;", false, nil},
+		{"ColorComponents", "test", "float red = 1; float green = 2; float blue = 3;", false,
+			testutils.Problems{{Message: "google/type/color.proto"}}},
+		{"ColorField", "test", "string background_color = 1;", true,
+			testutils.Problems{{Message: "`background_color`", Suggestion: "google.type.Color"}}},
+		{"ColorRepeated", "test", "repeated string palette_color = 1;", true,
+			testutils.Problems{{Message: "`palette_color`"}}},
+		{"PostalAddress", "test", "repeated string address_lines = 1;", true,
+			testutils.Problems{{Message: "google/type/postal_address.proto"}}},
+		{"PostalAddressSingular", "test", "string address_line1 = 1;", true,
+			testutils.Problems{{Message: "google/type/postal_address.proto"}}},
+		{"Date", "test", "string birth_date = 1;", true,
+			testutils.Problems{{Message: "google/type/date.proto", Suggestion: "google.type.Date"}}},
+		{"DateNotSuffix", "test", "string update = 1;", true, nil},
+		{"CommonTypePackage", "google.type", "double latitude = 1; double longitude = 2;", false, nil},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			f := testutils.ParseProto3Tmpl(t, `
+				package {{.Package}};
+
+				message Book {
+					{{.Fields}}
+				}
+			`, test)
+			m := f.GetMessageTypes()[0]
+			var d desc.Descriptor = m
+			if test.field {
+				d = m.GetFields()[0]
+			}
+			if diff := test.problems.SetDescriptor(d).Diff(commonTypes.Lint(f)); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestCommonTypesOptions(t *testing.T) {
+	f := testutils.ParseProto3String(t, `
+		message Book {
+			string cover_color = 1;
+			string publish_date = 2;
+		}
+	`)
+	for _, test := range []struct {
+		name     string
+		opts     lint.RuleOptions
+		problems testutils.Problems
+	}{
+		{"None", nil, testutils.Problems{
+			{Descriptor: f.GetMessageTypes()[0].GetFields()[0], Suggestion: "google.type.Color"},
+			{Descriptor: f.GetMessageTypes()[0].GetFields()[1], Suggestion: "google.type.Date"},
+		}},
+		{"IgnoredTypes", lint.RuleOptions{"ignored_types": []interface{}{"google.type.Color"}}, testutils.Problems{
+			{Descriptor: f.GetMessageTypes()[0].GetFields()[1], Suggestion: "google.type.Date"},
+		}},
+		{"Components", lint.RuleOptions{
+			"ignored_types": []interface{}{"google.type.Color", "google.type.Date"},
+			"components": []interface{}{map[string]interface{}{
+				"type":       "acme.type.Shade",
+				"import":     "acme/type/shade.proto",
+				"field_name": "color",
+				"groups": []interface{}{
+					[]interface{}{map[string]interface{}{"name": "color", "types": []interface{}{"string"}}},
+				},
+			}},
+		}, testutils.Problems{
+			{Descriptor: f.GetMessageTypes()[0].GetFields()[0], Message: "acme/type/shade.proto", Suggestion: "acme.type.Shade"},
+		}},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			rule, err := commonTypes.WithOptions(test.opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := test.problems.Diff(rule.Lint(f)); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestCommonTypesInvalidOptions(t *testing.T) {
+	for _, test := range []struct {
+		name string
+		opts lint.RuleOptions
+	}{
+		{"UnknownKey", lint.RuleOptions{"ignored": []interface{}{"google.type.Color"}}},
+		{"UnknownType", lint.RuleOptions{"ignored_types": []interface{}{"google.type.Colour"}}},
+		{"NotAList", lint.RuleOptions{"ignored_types": "google.type.Color"}},
+		{"ComponentUnknownField", lint.RuleOptions{"components": []interface{}{map[string]interface{}{"typ": "acme.Shade"}}}},
+		{"ComponentNoPackage", lint.RuleOptions{"components": []interface{}{map[string]interface{}{
+			"type": "Shade", "import": "shade.proto", "groups": []interface{}{[]interface{}{map[string]interface{}{"name": "shade", "types": []interface{}{"string"}}}},
+		}}}},
+		{"ComponentNoGroups", lint.RuleOptions{"components": []interface{}{map[string]interface{}{"type": "acme.Shade", "import": "shade.proto"}}}},
+		{"ComponentNoTypes", lint.RuleOptions{"components": []interface{}{map[string]interface{}{
+			"type": "acme.Shade", "import": "shade.proto", "groups": []interface{}{[]interface{}{map[string]interface{}{"name": "shade"}}},
+		}}}},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			if _, err := commonTypes.WithOptions(test.opts); err == nil {
+				t.Error("WithOptions() got nil error, want an error")
+			}
+		})
+	}
+}
+
+func TestCommonTypesComponentPackage(t *testing.T) {
+	rule, err := commonTypes.WithOptions(lint.RuleOptions{"components": []interface{}{map[string]interface{}{
+		"type":   "acme.type.Distance",
+		"import": "acme/type/distance.proto",
+		"groups": []interface{}{
+			[]interface{}{map[string]interface{}{"name": "meters", "types": []interface{}{"double"}}},
+			[]interface{}{map[string]interface{}{"name": "accuracy", "types": []interface{}{"double"}}},
+		},
+	}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, test := range []struct {
+		name     string
+		Package  string
+		problems testutils.Problems
+	}{
+		{"Other", "test", testutils.Problems{{Message: "`acme.type.Distance`"}}},
+		{"Defining", "acme.type", nil},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			f := testutils.ParseProto3Tmpl(t, `
+				package {{.Package}};
+
+				message Route {
+					double meters = 1;
+					double accuracy = 2;
+				}
+			`, test)
+			if diff := test.problems.SetDescriptor(f.GetMessageTypes()[0]).Diff(rule.Lint(f)); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
@@ -15,7 +15,6 @@
 package aip0215
 
 import (
-	"regexp"
 	"strings"
 
 	"github.com/googleapis/api-linter/lint"
@@ -49,7 +48,7 @@ var versionedPackages = &lint.FileRule{
 		return true
 	},
 	LintFile: func(f *desc.FileDescriptor) []lint.Problem {
-		if !version.MatchString(f.GetPackage()) {
+		if !utils.IsVersionedPackage(f.GetPackage()) {
 			return []lint.Problem{{
 				Message:    "API components should be in versioned packages.",
 				Descriptor: f,
@@ -59,5 +58,3 @@ var versionedPackages = &lint.FileRule{
 		return nil
 	},
 }
-
-var version = regexp.MustCompile(`\.v[\d]+(p[\d]+)?(alpha|beta|eap|test)?[\d]*`)
@@ -49,6 +49,7 @@ func TestVersionedPackages(t *testing.T) {
 		{"MasterSubpackage", "package foo.master.bar;", testutils.Problems{}},
 		{"VXMasterSubpackage", "package foo.v3master.bar;", testutils.Problems{}},
 		{"InvalidNoVersion", "package foo.bar;", testutils.Problems{{Message: "versioned packages"}}},
+		{"InvalidVersionPrefix", "package foo.bar.v1x;", testutils.Problems{{Message: "versioned packages"}}},
 		{"IgnoredRPC", "package google.rpc.foobar;", testutils.Problems{}},
 		{"IgnoredLRO", "package google.longrunning.foobar;", testutils.Problems{}},
 		{"IgnoredAPI", "package google.api.foobar;", testutils.Problems{}},
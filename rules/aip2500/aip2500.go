@@ -0,0 +1,57 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package aip2500 contains rules for the Cloud conventions on locations and
+// endpoints.
+//
+// These conventions apply to every Cloud API rather than coming from one of
+// the Cloud AIPs, so they are numbered after the first AIP of the Cloud block
+// (2500-2599), which puts them in the cloud rule group. Rules of a specific
+// Cloud AIP, such as the project identifiers of AIP-2510, go in the package of
+// that AIP instead.
+//
+// These are Cloud rules, which are disabled by default.
+package aip2500
+
+import (
+	"github.com/googleapis/api-linter/lint"
+	"github.com/googleapis/api-linter/locations"
+	"github.com/jhump/protoreflect/desc"
+	apb "google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/protobuf/proto"
+	dpb "google.golang.org/protobuf/types/descriptorpb"
+)
+
+// AddRules accepts a register function and registers each of
+// this AIP's rules to it.
+func AddRules(r lint.RuleRegistry) error {
+	return r.Register(
+		2500,
+		defaultHost,
+		locationCollection,
+		locationVariable,
+		regionalDefaultHost,
+	)
+}
+
+// getDefaultHost returns the `google.api.default_host` of the service, if
+// any.
+func getDefaultHost(s *desc.ServiceDescriptor) string {
+	host, _ := proto.GetExtension(s.GetServiceOptions(), apb.E_DefaultHost).(string)
+	return host
+}
+
+func defaultHostLocation(s *desc.ServiceDescriptor) *dpb.SourceCodeInfo_Location {
+	return locations.ServiceOption(s, int(apb.E_DefaultHost.TypeDescriptor().Number()))
+}
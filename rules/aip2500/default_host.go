@@ -0,0 +1,48 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aip2500
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/googleapis/api-linter/lint"
+	"github.com/jhump/protoreflect/desc"
+)
+
+// Client libraries connect to the `google.api.default_host` of a service,
+// which for Cloud APIs is a `googleapis.com` host name.
+var defaultHost = &lint.ServiceRule{
+	Name: lint.NewRuleName(2500, "default-host"),
+	LintService: func(s *desc.ServiceDescriptor) []lint.Problem {
+		host := getDefaultHost(s)
+		if host == "" {
+			return []lint.Problem{{
+				Message:    "Cloud services should set `google.api.default_host` to their `googleapis.com` endpoint, such as \"library.googleapis.com\".",
+				Descriptor: s,
+			}}
+		}
+		if !googleapisHost.MatchString(host) {
+			return []lint.Problem{{
+				Message:    fmt.Sprintf("The default host %q should be a `googleapis.com` host name, such as \"library.googleapis.com\", without a scheme, port or path.", host),
+				Descriptor: s,
+				Location:   defaultHostLocation(s),
+			}}
+		}
+		return nil
+	},
+}
+
+var googleapisHost = regexp.MustCompile(`^[a-z][a-z0-9-]*(\.[a-z][a-z0-9-]*)*\.googleapis\.com$`)
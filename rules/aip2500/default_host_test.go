@@ -0,0 +1,51 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aip2500
+
+import (
+	"testing"
+
+	"github.com/googleapis/api-linter/rules/internal/testutils"
+)
+
+func TestDefaultHost(t *testing.T) {
+	for _, test := range []struct {
+		name     string
+		Host     string
+		problems testutils.Problems
+	}{
+		{"Valid", "library.googleapis.com", nil},
+		{"ValidSubdomain", "library.sandbox.googleapis.com", nil},
+		{"Missing", "", testutils.Problems{{Message: "should set `google.api.default_host`"}}},
+		{"Scheme", "https://library.googleapis.com", testutils.Problems{{Message: "without a scheme, port or path"}}},
+		{"Port", "library.googleapis.com:443", testutils.Problems{{Message: "without a scheme, port or path"}}},
+		{"Path", "library.googleapis.com/v1", testutils.Problems{{Message: "without a scheme, port or path"}}},
+		{"OtherDomain", "library.example.com", testutils.Problems{{Message: "`googleapis.com` host name"}}},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			f := testutils.ParseProto3Tmpl(t, `
+				import "google/api/client.proto";
+
+				service Library {
+					{{if .Host}}option (google.api.default_host) = "{{.Host}}";{{end}}
+				}
+			`, test)
+			s := f.GetServices()[0]
+			if diff := test.problems.SetDescriptor(s).Diff(defaultHost.Lint(f)); diff != "" {
+				t.Errorf(diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,44 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aip2500
+
+import (
+	"fmt"
+
+	"github.com/googleapis/api-linter/lint"
+	"github.com/googleapis/api-linter/locations"
+	"github.com/googleapis/api-linter/rules/internal/utils"
+	"github.com/jhump/protoreflect/desc"
+)
+
+var locationVariable = &lint.MessageRule{
+	Name:   lint.NewRuleName(2500, "location-variable"),
+	OnlyIf: utils.HasResourcePatterns,
+	LintMessage: func(m *desc.MessageDescriptor) []lint.Problem {
+		for i, pattern := range utils.GetResource(m).GetPattern() {
+			want, replaced := utils.ReplacePatternSegments(pattern, func(collection, variable string) bool {
+				return collection == "locations" && variable != "location"
+			}, "locations", "location")
+			if replaced {
+				return []lint.Problem{{
+					Message:    fmt.Sprintf("Locations are identified by a `{location}` variable, such as %q.", want),
+					Descriptor: m,
+					Location:   locations.MessageResourcePattern(m, i),
+				}}
+			}
+		}
+		return nil
+	},
+}
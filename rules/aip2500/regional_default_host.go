@@ -0,0 +1,60 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aip2500
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/googleapis/api-linter/lint"
+	"github.com/jhump/protoreflect/desc"
+)
+
+// Clients choose a regional endpoint, such as
+// "us-central1-library.googleapis.com" or
+// "library.us-central1.rep.googleapis.com", for themselves; the default host
+// should be the global endpoint.
+var regionalDefaultHost = &lint.ServiceRule{
+	Name: lint.NewRuleName(2500, "regional-default-host"),
+	OnlyIf: func(s *desc.ServiceDescriptor) bool {
+		return getDefaultHost(s) != ""
+	},
+	LintService: func(s *desc.ServiceDescriptor) []lint.Problem {
+		host := getDefaultHost(s)
+		if global, ok := globalHost(host); ok {
+			return []lint.Problem{{
+				Message:    fmt.Sprintf("The default host %q is a regional endpoint; it should be the global endpoint %q, leaving clients to choose a region.", host, global),
+				Descriptor: s,
+				Location:   defaultHostLocation(s),
+			}}
+		}
+		return nil
+	},
+}
+
+// regionalHost matches the two forms of regional endpoints:
+// "{region}-{service}.googleapis.com" and
+// "{service}.{region}.rep.googleapis.com".
+var regionalHost = regexp.MustCompile(`^(?:[a-z]+-[a-z]+[0-9]+-([a-z][a-z0-9-]*)|([a-z][a-z0-9-]*)\.[a-z]+-[a-z]+[0-9]+\.rep)\.googleapis\.com$`)
+
+// globalHost returns the global endpoint of a regional endpoint, and whether
+// the host is a regional endpoint at all.
+func globalHost(host string) (string, bool) {
+	match := regionalHost.FindStringSubmatch(host)
+	if match == nil {
+		return "", false
+	}
+	return match[1] + match[2] + ".googleapis.com", true
+}
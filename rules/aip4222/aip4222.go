@@ -0,0 +1,132 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package aip4222 contains rules defined in https://aip.dev/4222.
+package aip4222
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/googleapis/api-linter/lint"
+	"github.com/googleapis/api-linter/rules/internal/utils"
+	"github.com/jhump/protoreflect/desc"
+)
+
+// AddRules accepts a register function and registers each of
+// this AIP's rules to it.
+func AddRules(r lint.RuleRegistry) error {
+	return r.Register(
+		4222,
+		httpVariables,
+		implicitRouting,
+		parameterField,
+		pathTemplate,
+	)
+}
+
+func hasRouting(m *desc.MethodDescriptor) bool {
+	return len(utils.GetRouting(m).GetRoutingParameters()) > 0
+}
+
+var captureName = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*(\.[A-Za-z_][A-Za-z0-9_]*)*$`)
+
+// routingTemplate is a parsed `path_template` of a routing parameter.
+type routingTemplate struct {
+	// captures are the names of the named segments.
+	captures []string
+
+	// segments are the segments the template matches, with the named
+	// segments expanded to their patterns.
+	segments []string
+}
+
+// parseRoutingTemplate parses a routing parameter's `path_template`, such
+// as "projects/*/{table_location=instances/*}/tables/*".
+func parseRoutingTemplate(tmpl string) (*routingTemplate, error) {
+	if tmpl == "" {
+		return nil, errors.New("the template is empty")
+	}
+	t := &routingTemplate{}
+	for rest := tmpl; ; {
+		if strings.HasPrefix(rest, "{") {
+			end := strings.Index(rest, "}")
+			if end < 0 {
+				return nil, errors.New("a named segment is not closed")
+			}
+			name, pattern, hasPattern := strings.Cut(rest[1:end], "=")
+			if !captureName.MatchString(name) {
+				return nil, fmt.Errorf("%q is not a valid segment name", name)
+			}
+			if !hasPattern {
+				pattern = "*"
+			}
+			for _, seg := range strings.Split(pattern, "/") {
+				if err := checkSegment(seg); err != nil {
+					return nil, err
+				}
+				t.segments = append(t.segments, seg)
+			}
+			t.captures = append(t.captures, name)
+			rest = rest[end+1:]
+		} else {
+			end := strings.Index(rest, "/")
+			if end < 0 {
+				end = len(rest)
+			}
+			if err := checkSegment(rest[:end]); err != nil {
+				return nil, err
+			}
+			t.segments = append(t.segments, rest[:end])
+			rest = rest[end:]
+		}
+		if rest == "" {
+			return t, nil
+		}
+		if rest[0] != '/' {
+			return nil, fmt.Errorf("unexpected %q after a named segment", rest[0])
+		}
+		rest = rest[1:]
+	}
+}
+
+// checkSegment returns an error if the segment is neither a wildcard nor a
+// literal.
+func checkSegment(seg string) error {
+	switch {
+	case seg == "":
+		return errors.New("the template has an empty segment")
+	case seg == "*" || seg == "**":
+		return nil
+	case strings.ContainsAny(seg, "{}=*"):
+		return fmt.Errorf("%q is not a valid segment", seg)
+	}
+	return nil
+}
+
+// canMatch returns true if some value may match both of the given patterns,
+// split into segments.
+func canMatch(a, b []string) bool {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		if a[i] == "**" || b[i] == "**" {
+			return true
+		}
+		if a[i] != "*" && b[i] != "*" && a[i] != b[i] {
+			return false
+		}
+	}
+	return len(a) == len(b)
+}
@@ -0,0 +1,89 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aip4222
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/googleapis/api-linter/lint"
+)
+
+func TestAddRules(t *testing.T) {
+	if err := AddRules(lint.NewRuleRegistry()); err != nil {
+		t.Errorf("AddRules got an error: %v", err)
+	}
+}
+
+func TestParseRoutingTemplate(t *testing.T) {
+	for _, test := range []struct {
+		name     string
+		tmpl     string
+		captures []string
+		segments []string
+		wantErr  bool
+	}{
+		{"Whole", "{routing_id=**}", []string{"routing_id"}, []string{"**"}, false},
+		{"Prefix", "{project=projects/*}/**", []string{"project"}, []string{"projects", "*", "**"}, false},
+		{"Middle", "projects/*/{table_location=instances/*}/tables/*", []string{"table_location"}, []string{"projects", "*", "instances", "*", "tables", "*"}, false},
+		{"NoPattern", "projects/{project}", []string{"project"}, []string{"projects", "*"}, false},
+		{"Unnamed", "projects/*/**", nil, []string{"projects", "*", "**"}, false},
+		{"Two", "{a=projects/*}/{b=books/*}", []string{"a", "b"}, []string{"projects", "*", "books", "*"}, false},
+		{"Empty", "", nil, nil, true},
+		{"EmptySegment", "projects//{project}", nil, nil, true},
+		{"TrailingSlash", "{project=projects/*}/", nil, nil, true},
+		{"Unclosed", "{project=projects/*", nil, nil, true},
+		{"Nested", "{project=projects/{id}}", nil, nil, true},
+		{"BadName", "{=projects/*}", nil, nil, true},
+		{"BadLiteral", "projects*/{project}", nil, nil, true},
+		{"AfterCapture", "{project}x", nil, nil, true},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := parseRoutingTemplate(test.tmpl)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("parseRoutingTemplate(%q) got error %v; want error %v", test.tmpl, err, test.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(test.captures, got.captures); diff != "" {
+				t.Errorf("captures got diff (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(test.segments, got.segments); diff != "" {
+				t.Errorf("segments got diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCanMatch(t *testing.T) {
+	for _, test := range []struct {
+		name string
+		a, b []string
+		want bool
+	}{
+		{"Same", []string{"projects", "*"}, []string{"projects", "*"}, true},
+		{"Wildcard", []string{"projects", "*"}, []string{"*", "p1"}, true},
+		{"DoubleWildcard", []string{"projects", "**"}, []string{"projects", "*", "books", "*"}, true},
+		{"Literal", []string{"projects", "*"}, []string{"shelves", "*"}, false},
+		{"Length", []string{"projects", "*"}, []string{"projects", "*", "books", "*"}, false},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			if got := canMatch(test.a, test.b); got != test.want {
+				t.Errorf("canMatch(%v, %v) = %v; want %v", test.a, test.b, got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aip4222
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/googleapis/api-linter/lint"
+	"github.com/googleapis/api-linter/locations"
+	"github.com/googleapis/api-linter/rules/internal/utils"
+	"github.com/jhump/protoreflect/desc"
+)
+
+var httpVariables = &lint.MethodRule{
+	Name:   lint.NewRuleName(4222, "http-variables"),
+	OnlyIf: hasRouting,
+	LintMethod: func(m *desc.MethodDescriptor) []lint.Problem {
+		// The routing headers implied by the HTTP bindings, by field path.
+		vars := map[string][]string{}
+		for _, httpRule := range utils.GetHTTPRules(m) {
+			for path, pattern := range httpRule.GetVariables() {
+				vars[path] = append(vars[path], pattern)
+			}
+		}
+
+		var problems []lint.Problem
+		for i, param := range utils.GetRouting(m).GetRoutingParameters() {
+			// Without a template, the header is named after the field, and
+			// cannot conflict.
+			t, err := parseRoutingTemplate(param.GetPathTemplate())
+			if err != nil || len(t.captures) != 1 {
+				continue
+			}
+			key := t.captures[0]
+			if _, ok := vars[key]; ok && key != param.GetField() {
+				problems = append(problems, lint.Problem{
+					Message:    fmt.Sprintf("Routing header %q is extracted from %q, but the HTTP URI implies it is the %q field.", key, param.GetField(), key),
+					Descriptor: m,
+					Location:   locations.MethodRoutingParameter(m, i),
+				})
+				continue
+			}
+			for _, pattern := range vars[param.GetField()] {
+				if !canMatch(t.segments, strings.Split(pattern, "/")) {
+					problems = append(problems, lint.Problem{
+						Message:    fmt.Sprintf("Routing path template %q never matches %q, as the HTTP URI requires it to match %q.", param.GetPathTemplate(), param.GetField(), pattern),
+						Descriptor: m,
+						Location:   locations.MethodRoutingParameter(m, i),
+					})
+					break
+				}
+			}
+		}
+		return problems
+	},
+}
@@ -0,0 +1,69 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aip4222
+
+import (
+	"testing"
+
+	"github.com/googleapis/api-linter/rules/internal/testutils"
+)
+
+func TestHTTPVariables(t *testing.T) {
+	for _, test := range []struct {
+		name     string
+		Field    string
+		Template string
+		problems testutils.Problems
+	}{
+		{"Valid", "name", "{project=projects/*}/**", nil},
+		{"ValidSameKey", "name", "{name=projects/*/books/*}", nil},
+		{"ValidNotInURI", "parent", "{project=projects/*}", nil},
+		{"ValidNoTemplate", "parent", "", nil},
+		{"KeyConflict", "parent", "{name=projects/*}", testutils.Problems{{Message: `implies it is the "name" field`}}},
+		{"NeverMatches", "name", "{shelf=shelves/*}/**", testutils.Problems{{Message: "never matches"}}},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			f := testutils.ParseProto3Tmpl(t, `
+				import "google/api/annotations.proto";
+				import "google/api/routing.proto";
+
+				service Library {
+					rpc GetBook(GetBookRequest) returns (Book) {
+						option (google.api.http) = {
+							get: "/v1/{name=projects/*/books/*}"
+						};
+						option (google.api.routing) = {
+							routing_parameters {
+								field: "{{.Field}}"
+								path_template: "{{.Template}}"
+							}
+						};
+					}
+				}
+
+				message GetBookRequest {
+					string name = 1;
+					string parent = 2;
+				}
+
+				message Book {}
+			`, test)
+			m := f.GetServices()[0].GetMethods()[0]
+			if diff := test.problems.SetDescriptor(m).Diff(httpVariables.Lint(f)); diff != "" {
+				t.Errorf(diff)
+			}
+		})
+	}
+}
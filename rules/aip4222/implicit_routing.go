@@ -0,0 +1,52 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aip4222
+
+import (
+	"fmt"
+	"strings"
+
+	"bitbucket.org/creachadair/stringset"
+	"github.com/googleapis/api-linter/lint"
+	"github.com/googleapis/api-linter/locations"
+	"github.com/googleapis/api-linter/rules/internal/utils"
+	"github.com/jhump/protoreflect/desc"
+)
+
+// implicitRouting reports the routing headers that a method without a
+// `google.api.routing` annotation sends, which are implied by its HTTP URI
+// variables. It only runs in verbose mode, as this is not a violation.
+var implicitRouting = lint.VerboseOnly(&lint.MethodRule{
+	Name: lint.NewRuleName(4222, "implicit-routing"),
+	OnlyIf: func(m *desc.MethodDescriptor) bool {
+		return utils.GetRouting(m) == nil
+	},
+	LintMethod: func(m *desc.MethodDescriptor) []lint.Problem {
+		keys := stringset.New()
+		for _, httpRule := range utils.GetHTTPRules(m) {
+			for path := range httpRule.GetVariables() {
+				keys.Add(path)
+			}
+		}
+		if keys.Empty() {
+			return nil
+		}
+		return []lint.Problem{{
+			Message:    fmt.Sprintf("Without a `google.api.routing` annotation, the routing headers are implied by the HTTP URI variables: %s.", strings.Join(keys.Elements(), ", ")),
+			Descriptor: m,
+			Location:   locations.MethodHTTPRule(m),
+		}}
+	},
+})
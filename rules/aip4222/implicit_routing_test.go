@@ -0,0 +1,67 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aip4222
+
+import (
+	"testing"
+
+	"github.com/googleapis/api-linter/rules/internal/testutils"
+)
+
+func TestImplicitRouting(t *testing.T) {
+	for _, test := range []struct {
+		name       string
+		URI        string
+		Additional string
+		Routing    string
+		problems   testutils.Problems
+	}{
+		{"Implicit", "/v1/{name=projects/*/books/*}", "", "", testutils.Problems{{Message: "HTTP URI variables: name."}}},
+		{"ImplicitAdditional", "/v1/{name=projects/*/books/*}", "/v1/{book.name=books/*}", "", testutils.Problems{{Message: "HTTP URI variables: book.name, name."}}},
+		{"NoVariables", "/v1/books", "", "", nil},
+		{"Explicit", "/v1/{name=projects/*/books/*}", "", `option (google.api.routing) = { routing_parameters { field: "name" } };`, nil},
+		{"Disabled", "/v1/{name=projects/*/books/*}", "", "option (google.api.routing) = {};", nil},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			f := testutils.ParseProto3Tmpl(t, `
+				import "google/api/annotations.proto";
+				import "google/api/routing.proto";
+
+				service Library {
+					rpc GetBook(GetBookRequest) returns (Book) {
+						option (google.api.http) = {
+							get: "{{.URI}}"
+							{{if .Additional}}additional_bindings { get: "{{.Additional}}" }{{end}}
+						};
+						{{.Routing}}
+					}
+				}
+
+				message GetBookRequest {
+					string name = 1;
+					Book book = 2;
+				}
+
+				message Book {
+					string name = 1;
+				}
+			`, test)
+			m := f.GetServices()[0].GetMethods()[0]
+			if diff := test.problems.SetDescriptor(m).Diff(implicitRouting.Lint(f)); diff != "" {
+				t.Errorf(diff)
+			}
+		})
+	}
+}
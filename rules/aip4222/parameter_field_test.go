@@ -0,0 +1,65 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aip4222
+
+import (
+	"testing"
+
+	"github.com/googleapis/api-linter/rules/internal/testutils"
+)
+
+func TestParameterField(t *testing.T) {
+	for _, test := range []struct {
+		name     string
+		Field    string
+		problems testutils.Problems
+	}{
+		{"Valid", "name", nil},
+		{"ValidNested", "book.name", nil},
+		{"Missing", "shelf", testutils.Problems{{Message: "not a field"}}},
+		{"Repeated", "tags", testutils.Problems{{Message: "singular string"}}},
+		{"NotString", "page_size", testutils.Problems{{Message: "singular string"}}},
+		{"Message", "book", testutils.Problems{{Message: "singular string"}}},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			f := testutils.ParseProto3Tmpl(t, `
+				import "google/api/routing.proto";
+
+				service Library {
+					rpc GetBook(GetBookRequest) returns (Book) {
+						option (google.api.routing) = {
+							routing_parameters { field: "{{.Field}}" }
+						};
+					}
+				}
+
+				message GetBookRequest {
+					string name = 1;
+					repeated string tags = 2;
+					int32 page_size = 3;
+					Book book = 4;
+				}
+
+				message Book {
+					string name = 1;
+				}
+			`, test)
+			m := f.GetServices()[0].GetMethods()[0]
+			if diff := test.problems.SetDescriptor(m).Diff(parameterField.Lint(f)); diff != "" {
+				t.Errorf(diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aip4222
+
+import (
+	"fmt"
+
+	"github.com/googleapis/api-linter/lint"
+	"github.com/googleapis/api-linter/locations"
+	"github.com/googleapis/api-linter/rules/internal/utils"
+	"github.com/jhump/protoreflect/desc"
+)
+
+var pathTemplate = &lint.MethodRule{
+	Name:   lint.NewRuleName(4222, "path-template"),
+	OnlyIf: hasRouting,
+	LintMethod: func(m *desc.MethodDescriptor) []lint.Problem {
+		var problems []lint.Problem
+		for i, param := range utils.GetRouting(m).GetRoutingParameters() {
+			// Without a template, the whole field is sent.
+			if param.GetPathTemplate() == "" {
+				continue
+			}
+			t, err := parseRoutingTemplate(param.GetPathTemplate())
+			if err != nil {
+				problems = append(problems, lint.Problem{
+					Message:    fmt.Sprintf("Routing path template %q is invalid: %v.", param.GetPathTemplate(), err),
+					Descriptor: m,
+					Location:   locations.MethodRoutingParameter(m, i),
+				})
+				continue
+			}
+			if len(t.captures) != 1 {
+				problems = append(problems, lint.Problem{
+					Message:    fmt.Sprintf("Routing path template %q must have exactly one named segment, not %d.", param.GetPathTemplate(), len(t.captures)),
+					Descriptor: m,
+					Location:   locations.MethodRoutingParameter(m, i),
+				})
+			}
+		}
+		return problems
+	},
+}
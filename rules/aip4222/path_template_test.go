@@ -0,0 +1,62 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aip4222
+
+import (
+	"testing"
+
+	"github.com/googleapis/api-linter/rules/internal/testutils"
+)
+
+func TestPathTemplate(t *testing.T) {
+	for _, test := range []struct {
+		name     string
+		Template string
+		problems testutils.Problems
+	}{
+		{"Valid", "{project=projects/*}/**", nil},
+		{"ValidNone", "", nil},
+		{"Invalid", "{project=projects/*", testutils.Problems{{Message: "is invalid"}}},
+		{"NoCapture", "projects/*/**", testutils.Problems{{Message: "exactly one named segment, not 0"}}},
+		{"TwoCaptures", "{project=projects/*}/{book=books/*}", testutils.Problems{{Message: "exactly one named segment, not 2"}}},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			f := testutils.ParseProto3Tmpl(t, `
+				import "google/api/routing.proto";
+
+				service Library {
+					rpc GetBook(GetBookRequest) returns (Book) {
+						option (google.api.routing) = {
+							routing_parameters {
+								field: "name"
+								path_template: "{{.Template}}"
+							}
+						};
+					}
+				}
+
+				message GetBookRequest {
+					string name = 1;
+				}
+
+				message Book {}
+			`, test)
+			m := f.GetServices()[0].GetMethods()[0]
+			if diff := test.problems.SetDescriptor(m).Diff(pathTemplate.Lint(f)); diff != "" {
+				t.Errorf(diff)
+			}
+		})
+	}
+}
@@ -26,6 +26,7 @@ import (
 func AddRules(r lint.RuleRegistry) error {
 	return r.Register(
 		4232,
+		nestedFields,
 		repeatedFields,
 		requiredFields,
 	)
@@ -0,0 +1,48 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aip4232
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/googleapis/api-linter/lint"
+	"github.com/googleapis/api-linter/locations"
+	"github.com/googleapis/api-linter/rules/internal/utils"
+	"github.com/jhump/protoreflect/desc"
+)
+
+// Nested fields in a method_signature must resolve to fields of the request.
+var nestedFields = &lint.MethodRule{
+	Name:   lint.NewRuleName(4232, "nested-fields"),
+	OnlyIf: hasMethodSignatures,
+	LintMethod: func(m *desc.MethodDescriptor) []lint.Problem {
+		var problems []lint.Problem
+		in := m.GetInputType()
+		for i, sig := range utils.GetMethodSignatures(m) {
+			for _, name := range sig {
+				if !strings.Contains(name, ".") || utils.FindFieldDotNotation(in, name) != nil {
+					continue
+				}
+				problems = append(problems, lint.Problem{
+					Message:    fmt.Sprintf("Method signature field %q does not resolve to a field of %q.", name, in.GetName()),
+					Descriptor: m,
+					Location:   locations.MethodSignature(m, i),
+				})
+			}
+		}
+		return problems
+	},
+}
@@ -0,0 +1,68 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aip4232
+
+import (
+	"testing"
+
+	"github.com/googleapis/api-linter/rules/internal/testutils"
+)
+
+func TestNestedFields(t *testing.T) {
+	tests := []struct {
+		testName string
+		Sig      string
+		problems testutils.Problems
+	}{
+		{"Valid", "name,book.author.name", nil},
+		{"ValidTopLevelOnly", "name,paperback_only", nil},
+		{"UnknownField", "name,book.autor.name", testutils.Problems{{Message: "book.autor.name"}}},
+		{"ScalarTraversal", "name,book.title.length", testutils.Problems{{Message: "book.title.length"}}},
+		{"Several", "book.x,book.y", testutils.Problems{{Message: "book.x"}, {Message: "book.y"}}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.testName, func(t *testing.T) {
+			f := testutils.ParseProto3Tmpl(t, `
+				import "google/api/client.proto";
+				service Library {
+					rpc ArchiveBook(ArchiveBookRequest) returns (ArchiveBookResponse) {
+						option (google.api.method_signature) = "{{.Sig}}";
+					}
+				}
+				message ArchiveBookRequest {
+					string name = 1;
+
+					bool paperback_only = 2;
+
+					Book book = 3;
+				}
+				message Book {
+					string title = 1;
+
+					Author author = 2;
+				}
+				message Author {
+					string name = 1;
+				}
+				message ArchiveBookResponse {}
+			`, test)
+			method := f.GetServices()[0].GetMethods()[0]
+			if diff := test.problems.SetDescriptor(method).Diff(nestedFields.Lint(f)); diff != "" {
+				t.Error(diff)
+			}
+		})
+	}
+}
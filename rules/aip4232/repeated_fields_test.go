@@ -30,6 +30,8 @@ func TestRepeatedFields(t *testing.T) {
 		{"Valid", "name,paperback_only,book.editions", "name,editions", nil},
 		{"InvalidFirstSignature", "name,book.shelves.shelf,paperback_only", "name,editions", testutils.Problems{{Message: "only the last"}}},
 		{"InvalidSecondSignature", "name,book.editions", "name,book.shelves.shelf,paperback_only", testutils.Problems{{Message: "only the last"}}},
+		{"RepeatedScalarTraversed", "name,book.editions.value.extra", "name,editions", testutils.Problems{{Message: "only the last"}}},
+		{"SingularScalarTraversed", "name,book.name.value", "name,editions", nil},
 		{"BothInvalid", "name,book.shelves.shelf,paperback_only", "name,book.shelves.shelf,paperback_only", testutils.Problems{{Message: "only the last"}, {Message: "only the last"}}},
 	}
 
@@ -0,0 +1,105 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package aip4235 contains rules defined in https://aip.dev/4235.
+package aip4235
+
+import (
+	"bitbucket.org/creachadair/stringset"
+	"github.com/googleapis/api-linter/lint"
+	"github.com/googleapis/api-linter/rules/internal/utils"
+	"github.com/jhump/protoreflect/desc"
+	apb "google.golang.org/genproto/googleapis/api/annotations"
+)
+
+// AddRules accepts a register function and registers each of
+// this AIP's rules to it.
+func AddRules(r lint.RuleRegistry) error {
+	return r.Register(
+		4235,
+		autoPopulatedFormat,
+		autoPopulatedNotRequired,
+		autoPopulatedServiceConfig,
+	)
+}
+
+// listedNames returns the fields listed under `auto_populated_fields` for
+// the method in the service config, if any.
+func listedNames(m *desc.MethodDescriptor, set *lint.FileSet) stringset.Set {
+	cfg := utils.GetServiceConfig(set)
+	return stringset.New(utils.GetMethodSettings(cfg, m).GetAutoPopulatedFields()...)
+}
+
+// isListed returns true if the field is listed under `auto_populated_fields`
+// for any method taking its message as the request.
+func isListed(f *desc.FieldDescriptor, set *lint.FileSet) bool {
+	for _, m := range requestMethods(f.GetOwner(), set) {
+		if listedNames(m, set).Contains(f.GetName()) {
+			return true
+		}
+	}
+	return false
+}
+
+// isRequestField returns true if the field belongs to the request message of
+// a method.
+func isRequestField(f *desc.FieldDescriptor, set *lint.FileSet) bool {
+	return len(requestMethods(f.GetOwner(), set)) > 0
+}
+
+type requestMethodsKey struct{}
+
+// requestMethods returns the methods taking the message as their request.
+// With the FileSet of a lint run, methods of every file in the run are
+// considered; otherwise, only those of the message's file are.
+func requestMethods(m *desc.MessageDescriptor, set *lint.FileSet) []*desc.MethodDescriptor {
+	if set != nil {
+		index := set.Value(requestMethodsKey{}, func(files []*desc.FileDescriptor) interface{} {
+			return newRequestMethodsIndex(files...)
+		}).(map[string][]*desc.MethodDescriptor)
+		return index[m.GetFullyQualifiedName()]
+	}
+	return newRequestMethodsIndex(m.GetFile())[m.GetFullyQualifiedName()]
+}
+
+// newRequestMethodsIndex maps the names of request messages to the methods
+// of the files taking them.
+func newRequestMethodsIndex(files ...*desc.FileDescriptor) map[string][]*desc.MethodDescriptor {
+	index := map[string][]*desc.MethodDescriptor{}
+	for _, f := range files {
+		for _, s := range f.GetServices() {
+			for _, m := range s.GetMethods() {
+				name := m.GetInputType().GetFullyQualifiedName()
+				index[name] = append(index[name], m)
+			}
+		}
+	}
+	return index
+}
+
+// requestFields returns the fields of the method's request message for which
+// include returns true.
+func requestFields(m *desc.MethodDescriptor, include func(*desc.FieldDescriptor) bool) []*desc.FieldDescriptor {
+	var fields []*desc.FieldDescriptor
+	for _, f := range m.GetInputType().GetFields() {
+		if include(f) {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+func isUUID4(f *desc.FieldDescriptor) bool {
+	return utils.GetFormat(f) == apb.FieldInfo_UUID4
+}
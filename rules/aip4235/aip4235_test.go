@@ -0,0 +1,61 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aip4235
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/googleapis/api-linter/lint"
+	"github.com/jhump/protoreflect/desc"
+	apb "google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/genproto/googleapis/api/serviceconfig"
+)
+
+func TestAddRules(t *testing.T) {
+	if err := AddRules(lint.NewRuleRegistry()); err != nil {
+		t.Errorf("AddRules got an error: %v", err)
+	}
+}
+
+// serviceConfig returns a service config listing the given comma-separated
+// fields for the method, or nil if listed is "-".
+func serviceConfig(method, listed string) *serviceconfig.Service {
+	if listed == "-" {
+		return nil
+	}
+	settings := &apb.MethodSettings{Selector: method}
+	if listed != "" {
+		settings.AutoPopulatedFields = strings.Split(listed, ",")
+	}
+	return &serviceconfig.Service{
+		Publishing: &apb.Publishing{MethodSettings: []*apb.MethodSettings{settings}},
+	}
+}
+
+// lintWithServiceConfig runs the rule on the file in a lint run with the
+// given service config.
+func lintWithServiceConfig(t *testing.T, rule lint.ProtoRule, f *desc.FileDescriptor, cfg *serviceconfig.Service) []lint.Problem {
+	t.Helper()
+	registry := lint.NewRuleRegistry()
+	if err := registry.Register(4235, rule); err != nil {
+		t.Fatal(err)
+	}
+	resps, err := lint.New(registry, nil, lint.ServiceConfig(cfg)).LintProtos(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return resps[0].Problems
+}
@@ -0,0 +1,50 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aip4235
+
+import (
+	"fmt"
+
+	"github.com/googleapis/api-linter/lint"
+	"github.com/jhump/protoreflect/desc"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// The fields listed for auto-population in the service config should be
+// UUID4 strings. The `request_id` field should be a string even if it is not
+// listed; its format is checked by core::0155::request-id-format.
+var autoPopulatedFormat = lint.NewFileSetRule(func(set *lint.FileSet) lint.ProtoRule {
+	return &lint.FieldRule{
+		Name: lint.NewRuleName(4235, "auto-populated-format"),
+		OnlyIf: func(f *desc.FieldDescriptor) bool {
+			return isRequestField(f, set) && (f.GetName() == "request_id" || isListed(f, set))
+		},
+		LintField: func(f *desc.FieldDescriptor) []lint.Problem {
+			if f.IsRepeated() || f.GetType() != descriptorpb.FieldDescriptorProto_TYPE_STRING {
+				return []lint.Problem{{
+					Message:    fmt.Sprintf("Field %q should be a singular string for client libraries to auto-populate it.", f.GetName()),
+					Descriptor: f,
+				}}
+			}
+			if f.GetName() != "request_id" && !isUUID4(f) {
+				return []lint.Problem{{
+					Message:    fmt.Sprintf("Field %q should have `(google.api.field_info).format = UUID4` for client libraries to auto-populate it.", f.GetName()),
+					Descriptor: f,
+				}}
+			}
+			return nil
+		},
+	}
+})
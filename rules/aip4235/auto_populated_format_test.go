@@ -0,0 +1,142 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aip4235
+
+import (
+	"testing"
+
+	"github.com/googleapis/api-linter/lint"
+	"github.com/googleapis/api-linter/rules/internal/testutils"
+)
+
+func TestAutoPopulatedFormat(t *testing.T) {
+	for _, test := range []struct {
+		name     string
+		Field    string
+		Type     string
+		Format   string
+		Listed   string
+		problems testutils.Problems
+	}{
+		{"Valid", "request_id", "string", "UUID4", "-", nil},
+		{"ValidListed", "operation_id", "string", "UUID4", "operation_id", nil},
+		{"ValidNotCandidate", "operation_id", "string", "IPV4", "", nil},
+		{"ValidNotCandidateType", "operation_id", "int64", "", "", nil},
+		// The format of request_id is checked by core::0155::request-id-format.
+		{"RequestIDMissingFormat", "request_id", "string", "", "-", nil},
+		{"NotString", "request_id", "int64", "", "-", testutils.Problems{{Message: "singular string"}}},
+		{"Repeated", "request_id", "repeated string", "UUID4", "-", testutils.Problems{{Message: "singular string"}}},
+		{"ListedNotString", "operation_id", "int64", "", "operation_id", testutils.Problems{{Message: "singular string"}}},
+		{"ListedMissingFormat", "operation_id", "string", "", "operation_id", testutils.Problems{{Message: "UUID4"}}},
+		{"ListedWrongFormat", "operation_id", "string", "IPV4", "operation_id", testutils.Problems{{Message: "UUID4"}}},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			f := testutils.ParseProto3Tmpl(t, `
+				package test;
+				import "google/api/field_info.proto";
+
+				service Library {
+					rpc CreateBook(CreateBookRequest) returns (Book);
+				}
+
+				message CreateBookRequest {
+					{{.Type}} {{.Field}} = 1{{if .Format}} [(google.api.field_info).format = {{.Format}}]{{end}};
+				}
+
+				message Book {}
+			`, test)
+			field := f.GetMessageTypes()[0].GetFields()[0]
+			problems := lintWithServiceConfig(t, autoPopulatedFormat, f, serviceConfig("test.Library.CreateBook", test.Listed))
+			if diff := test.problems.SetDescriptor(field).Diff(problems); diff != "" {
+				t.Errorf(diff)
+			}
+		})
+	}
+}
+
+func TestAutoPopulatedFormatNotRequest(t *testing.T) {
+	f := testutils.ParseProto3String(t, `
+		package test;
+
+		message Book {
+			int64 request_id = 1;
+		}
+	`)
+	if diff := (testutils.Problems{}).Diff(autoPopulatedFormat.Lint(f)); diff != "" {
+		t.Errorf(diff)
+	}
+}
+
+func TestAutoPopulatedFormatSharedRequest(t *testing.T) {
+	f := testutils.ParseProto3String(t, `
+		package test;
+
+		service Library {
+			rpc CreateBook(CreateBookRequest) returns (Book);
+			rpc CreateBookAsync(CreateBookRequest) returns (Book);
+		}
+
+		message CreateBookRequest {
+			int64 request_id = 1;
+		}
+
+		message Book {}
+	`)
+	field := f.GetMessageTypes()[0].GetFields()[0]
+	want := testutils.Problems{{Message: "singular string", Descriptor: field}}
+	if diff := want.Diff(autoPopulatedFormat.Lint(f)); diff != "" {
+		t.Errorf(diff)
+	}
+}
+
+func TestAutoPopulatedFormatImportedRequest(t *testing.T) {
+	files := testutils.ParseProtoStrings(t, map[string]string{
+		"messages.proto": `
+			syntax = "proto3";
+			package test;
+
+			message CreateBookRequest {
+				int64 request_id = 1;
+			}
+
+			message Book {}
+		`,
+		"service.proto": `
+			syntax = "proto3";
+			package test;
+			import "messages.proto";
+
+			service Library {
+				rpc CreateBook(CreateBookRequest) returns (Book);
+			}
+		`,
+	})
+	registry := lint.NewRuleRegistry()
+	if err := registry.Register(4235, autoPopulatedFormat); err != nil {
+		t.Fatal(err)
+	}
+	resps, err := lint.New(registry, nil).LintProtos(files["messages.proto"], files["service.proto"])
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The field is reported in the file that defines it, and only there.
+	field := files["messages.proto"].GetMessageTypes()[0].GetFields()[0]
+	want := []testutils.Problems{{{Message: "singular string", Descriptor: field}}, nil}
+	for i, resp := range resps {
+		if diff := want[i].Diff(resp.Problems); diff != "" {
+			t.Errorf("%s: %s", resp.FilePath, diff)
+		}
+	}
+}
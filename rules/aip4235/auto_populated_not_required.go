@@ -0,0 +1,43 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aip4235
+
+import (
+	"fmt"
+
+	"github.com/googleapis/api-linter/lint"
+	"github.com/googleapis/api-linter/rules/internal/utils"
+	"github.com/jhump/protoreflect/desc"
+)
+
+// Client libraries do not auto-populate REQUIRED fields.
+var autoPopulatedNotRequired = lint.NewFileSetRule(func(set *lint.FileSet) lint.ProtoRule {
+	return &lint.FieldRule{
+		Name: lint.NewRuleName(4235, "auto-populated-not-required"),
+		OnlyIf: func(f *desc.FieldDescriptor) bool {
+			return isRequestField(f, set) && (isUUID4(f) || isListed(f, set))
+		},
+		LintField: func(f *desc.FieldDescriptor) []lint.Problem {
+			if utils.GetFieldBehavior(f).Contains("REQUIRED") {
+				return []lint.Problem{{
+					Message:    fmt.Sprintf("Field %q should not be REQUIRED, as client libraries only auto-populate optional fields.", f.GetName()),
+					Descriptor: f,
+					Location:   utils.FieldBehaviorLocation(f, "REQUIRED"),
+				}}
+			}
+			return nil
+		},
+	}
+})
@@ -0,0 +1,62 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aip4235
+
+import (
+	"testing"
+
+	"github.com/googleapis/api-linter/rules/internal/testutils"
+)
+
+func TestAutoPopulatedNotRequired(t *testing.T) {
+	for _, test := range []struct {
+		name     string
+		Format   string
+		Behavior string
+		Listed   string
+		problems testutils.Problems
+	}{
+		{"Valid", "UUID4", "OPTIONAL", "-", nil},
+		{"ValidNotAutoPopulated", "", "REQUIRED", "-", nil},
+		{"Required", "UUID4", "REQUIRED", "-", testutils.Problems{{Message: "should not be REQUIRED"}}},
+		{"RequiredListed", "", "REQUIRED", "request_id", testutils.Problems{{Message: "should not be REQUIRED"}}},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			f := testutils.ParseProto3Tmpl(t, `
+				package test;
+				import "google/api/field_behavior.proto";
+				import "google/api/field_info.proto";
+
+				service Library {
+					rpc CreateBook(CreateBookRequest) returns (Book);
+				}
+
+				message CreateBookRequest {
+					string request_id = 1 [
+						{{if .Format}}(google.api.field_info).format = {{.Format}},{{end}}
+						(google.api.field_behavior) = {{.Behavior}}
+					];
+				}
+
+				message Book {}
+			`, test)
+			field := f.GetMessageTypes()[0].GetFields()[0]
+			problems := lintWithServiceConfig(t, autoPopulatedNotRequired, f, serviceConfig("test.Library.CreateBook", test.Listed))
+			if diff := test.problems.SetDescriptor(field).Diff(problems); diff != "" {
+				t.Errorf(diff)
+			}
+		})
+	}
+}
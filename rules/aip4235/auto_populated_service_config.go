@@ -0,0 +1,55 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aip4235
+
+import (
+	"fmt"
+
+	"github.com/googleapis/api-linter/lint"
+	"github.com/googleapis/api-linter/rules/internal/utils"
+	"github.com/jhump/protoreflect/desc"
+)
+
+// When a service config is given, it should list the UUID4 fields of each
+// request under `auto_populated_fields`, and only fields of the request.
+var autoPopulatedServiceConfig = lint.NewFileSetRule(func(set *lint.FileSet) lint.ProtoRule {
+	return &lint.MethodRule{
+		Name: lint.NewRuleName(4235, "auto-populated-service-config"),
+		OnlyIf: func(m *desc.MethodDescriptor) bool {
+			return utils.GetServiceConfig(set) != nil
+		},
+		LintMethod: func(m *desc.MethodDescriptor) []lint.Problem {
+			listed := listedNames(m, set)
+			var problems []lint.Problem
+			for _, f := range requestFields(m, isUUID4) {
+				if !listed.Contains(f.GetName()) {
+					problems = append(problems, lint.Problem{
+						Message:    fmt.Sprintf("The service config should list %q under `auto_populated_fields` for %q, for client libraries to auto-populate it.", f.GetName(), m.GetFullyQualifiedName()),
+						Descriptor: m,
+					})
+				}
+			}
+			for _, name := range listed.Elements() {
+				if m.GetInputType().FindFieldByName(name) == nil {
+					problems = append(problems, lint.Problem{
+						Message:    fmt.Sprintf("The service config lists %q under `auto_populated_fields` for %q, but it is not a field of %q.", name, m.GetFullyQualifiedName(), m.GetInputType().GetName()),
+						Descriptor: m,
+					})
+				}
+			}
+			return problems
+		},
+	}
+})
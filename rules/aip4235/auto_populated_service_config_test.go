@@ -0,0 +1,59 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package aip4235
+
+import (
+	"testing"
+
+	"github.com/googleapis/api-linter/rules/internal/testutils"
+)
+
+func TestAutoPopulatedServiceConfig(t *testing.T) {
+	for _, test := range []struct {
+		name     string
+		Method   string
+		Listed   string
+		problems testutils.Problems
+	}{
+		{"Valid", "test.Library.CreateBook", "request_id", nil},
+		{"ValidNoServiceConfig", "test.Library.CreateBook", "-", nil},
+		{"NotListed", "test.Library.CreateBook", "", testutils.Problems{{Message: `should list "request_id"`}}},
+		{"NoMethodSettings", "test.Library.DeleteBook", "request_id", testutils.Problems{{Message: `should list "request_id"`}}},
+		{"ListedUnknown", "test.Library.CreateBook", "request_id,operation_id", testutils.Problems{{Message: `"operation_id" under`}}},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			f := testutils.ParseProto3Tmpl(t, `
+				package test;
+				import "google/api/field_info.proto";
+
+				service Library {
+					rpc CreateBook(CreateBookRequest) returns (Book);
+				}
+
+				message CreateBookRequest {
+					string request_id = 1 [(google.api.field_info).format = UUID4];
+					string parent = 2;
+				}
+
+				message Book {}
+			`, test)
+			m := f.GetServices()[0].GetMethods()[0]
+			problems := lintWithServiceConfig(t, autoPopulatedServiceConfig, f, serviceConfig(test.Method, test.Listed))
+			if diff := test.problems.SetDescriptor(m).Diff(problems); diff != "" {
+				t.Errorf(diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,439 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package filter parses the filter expressions described in AIP-160.
+package filter
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Expr is a node of a parsed filter expression.
+type Expr interface {
+	isExpr()
+}
+
+// And matches if all of its expressions match. It comes from the `AND`
+// keyword as well as from expressions separated by whitespace.
+type And struct {
+	Exprs []Expr
+}
+
+// Or matches if any of its expressions match.
+type Or struct {
+	Exprs []Expr
+}
+
+// Not negates an expression, written `NOT a` or `-a`.
+type Not struct {
+	Expr Expr
+}
+
+// Restriction compares a member or a function call to an argument, such as
+// `author = "Tolkien"`. A restriction without a comparator, such as
+// `Tolkien`, is a global restriction.
+type Restriction struct {
+	// Comparable is a *Member or a *Function.
+	Comparable Expr
+
+	// Comparator is one of `=`, `!=`, `<`, `<=`, `>`, `>=` and `:`, or empty
+	// for a global restriction.
+	Comparator string
+
+	// Arg is the argument compared to. It is a *Member, a *Function or a
+	// parenthesized expression, and is nil for a global restriction.
+	Arg Expr
+}
+
+// Member is a value or a field traversal, such as `author.name`. Quoted
+// strings are unquoted.
+type Member struct {
+	Path []string
+}
+
+// Function is a function call, such as `cohort(request.user)`.
+type Function struct {
+	Name []string
+	Args []Expr
+}
+
+func (*And) isExpr()         {}
+func (*Or) isExpr()          {}
+func (*Not) isExpr()         {}
+func (*Restriction) isExpr() {}
+func (*Member) isExpr()      {}
+func (*Function) isExpr()    {}
+
+// SyntaxError describes where and why a filter failed to parse.
+type SyntaxError struct {
+	// Offset is the byte offset in the filter where the error was found.
+	Offset  int
+	Message string
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("%s at offset %d", e.Message, e.Offset)
+}
+
+// Parse parses a filter expression. An empty filter parses to nil.
+func Parse(filter string) (Expr, error) {
+	tokens, err := lex(filter)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{tokens: tokens}
+	if p.peek().kind == tokenEOF {
+		return nil, nil
+	}
+	e, err := p.expression()
+	if err != nil {
+		return nil, err
+	}
+	if t := p.peek(); t.kind != tokenEOF {
+		return nil, p.unexpected(t)
+	}
+	return e, nil
+}
+
+// Restrictions returns the restrictions in an expression, including those
+// within function arguments, in the order in which they appear.
+func Restrictions(e Expr) []*Restriction {
+	var rs []*Restriction
+	var walk func(Expr)
+	walk = func(e Expr) {
+		switch e := e.(type) {
+		case *And:
+			for _, x := range e.Exprs {
+				walk(x)
+			}
+		case *Or:
+			for _, x := range e.Exprs {
+				walk(x)
+			}
+		case *Not:
+			walk(e.Expr)
+		case *Restriction:
+			rs = append(rs, e)
+			walk(e.Comparable)
+			walk(e.Arg)
+		case *Function:
+			for _, x := range e.Args {
+				walk(x)
+			}
+		}
+	}
+	walk(e)
+	return rs
+}
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenText
+	tokenString
+	tokenComparator
+	tokenLParen
+	tokenRParen
+	tokenDot
+	tokenComma
+)
+
+type token struct {
+	kind   tokenKind
+	text   string
+	offset int
+}
+
+// keyword reports whether the token is the given keyword. Keywords are
+// case-sensitive.
+func (t token) keyword(k string) bool {
+	return t.kind == tokenText && t.text == k
+}
+
+// special holds the characters that end a text token.
+const special = `()., <>=!:"'` + "\t\r\n"
+
+func lex(s string) ([]token, error) {
+	var tokens []token
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\r' || c == '\n':
+			i++
+		case c == '(':
+			tokens = append(tokens, token{tokenLParen, "(", i})
+			i++
+		case c == ')':
+			tokens = append(tokens, token{tokenRParen, ")", i})
+			i++
+		case c == '.':
+			tokens = append(tokens, token{tokenDot, ".", i})
+			i++
+		case c == ',':
+			tokens = append(tokens, token{tokenComma, ",", i})
+			i++
+		case c == '=' || c == ':':
+			tokens = append(tokens, token{tokenComparator, string(c), i})
+			i++
+		case c == '<' || c == '>' || c == '!':
+			if i+1 < len(s) && s[i+1] == '=' {
+				tokens = append(tokens, token{tokenComparator, s[i : i+2], i})
+				i += 2
+				continue
+			}
+			if c == '!' {
+				return nil, &SyntaxError{Offset: i, Message: `expected "=" after "!"`}
+			}
+			tokens = append(tokens, token{tokenComparator, string(c), i})
+			i++
+		case c == '"' || c == '\'':
+			str, n, err := lexString(s[i:])
+			if err != nil {
+				return nil, &SyntaxError{Offset: i, Message: err.Error()}
+			}
+			tokens = append(tokens, token{tokenString, str, i})
+			i += n
+		default:
+			start := i
+			for i < len(s) && !strings.ContainsRune(special, rune(s[i])) {
+				i++
+			}
+			tokens = append(tokens, token{tokenText, s[start:i], start})
+		}
+	}
+	return append(tokens, token{tokenEOF, "", len(s)}), nil
+}
+
+// lexString reads the quoted string at the start of s, returning its
+// unquoted value and its length in s.
+func lexString(s string) (string, int, error) {
+	quote := s[0]
+	var b strings.Builder
+	for i := 1; i < len(s); i++ {
+		switch s[i] {
+		case quote:
+			return b.String(), i + 1, nil
+		case '\\':
+			if i+1 == len(s) {
+				break
+			}
+			i++
+		}
+		b.WriteByte(s[i])
+	}
+	return "", 0, fmt.Errorf("unterminated string")
+}
+
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.pos]
+	if t.kind != tokenEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *parser) unexpected(t token) error {
+	if t.kind == tokenEOF {
+		return &SyntaxError{Offset: t.offset, Message: "unexpected end of filter"}
+	}
+	return &SyntaxError{Offset: t.offset, Message: fmt.Sprintf("unexpected %q", t.text)}
+}
+
+// expression: sequence {AND sequence}
+func (p *parser) expression() (Expr, error) {
+	return p.list(p.sequence, "AND", func(exprs []Expr) Expr { return &And{Exprs: exprs} })
+}
+
+// sequence: factor {factor}
+func (p *parser) sequence() (Expr, error) {
+	var exprs []Expr
+	for {
+		e, err := p.factor()
+		if err != nil {
+			return nil, err
+		}
+		exprs = append(exprs, e)
+		if !p.startsTerm() {
+			break
+		}
+	}
+	if len(exprs) == 1 {
+		return exprs[0], nil
+	}
+	return &And{Exprs: exprs}, nil
+}
+
+// factor: term {OR term}
+func (p *parser) factor() (Expr, error) {
+	return p.list(p.term, "OR", func(exprs []Expr) Expr { return &Or{Exprs: exprs} })
+}
+
+// list parses elements separated by the given keyword.
+func (p *parser) list(element func() (Expr, error), keyword string, join func([]Expr) Expr) (Expr, error) {
+	e, err := element()
+	if err != nil {
+		return nil, err
+	}
+	exprs := []Expr{e}
+	for p.peek().keyword(keyword) {
+		p.next()
+		e, err := element()
+		if err != nil {
+			return nil, err
+		}
+		exprs = append(exprs, e)
+	}
+	if len(exprs) == 1 {
+		return exprs[0], nil
+	}
+	return join(exprs), nil
+}
+
+// startsTerm reports whether the next token can start a term.
+func (p *parser) startsTerm() bool {
+	t := p.peek()
+	switch t.kind {
+	case tokenString, tokenLParen:
+		return true
+	case tokenText:
+		return !t.keyword("AND") && !t.keyword("OR")
+	}
+	return false
+}
+
+// term: [NOT | MINUS] simple
+func (p *parser) term() (Expr, error) {
+	t := p.peek()
+	switch {
+	case t.keyword("NOT"), t.keyword("-") && p.tokens[p.pos+1].kind == tokenLParen:
+		p.next()
+	case t.kind == tokenText && len(t.text) > 1 && t.text[0] == '-':
+		// Strip the minus sign from the token, leaving it to be parsed.
+		p.tokens[p.pos] = token{tokenText, t.text[1:], t.offset + 1}
+	default:
+		return p.simple()
+	}
+	e, err := p.simple()
+	if err != nil {
+		return nil, err
+	}
+	return &Not{Expr: e}, nil
+}
+
+// simple: restriction | composite
+func (p *parser) simple() (Expr, error) {
+	if p.peek().kind == tokenLParen {
+		return p.composite()
+	}
+	return p.restriction()
+}
+
+// composite: LPAREN expression RPAREN
+func (p *parser) composite() (Expr, error) {
+	p.next()
+	e, err := p.expression()
+	if err != nil {
+		return nil, err
+	}
+	if t := p.next(); t.kind != tokenRParen {
+		return nil, p.unexpected(t)
+	}
+	return e, nil
+}
+
+// restriction: comparable [comparator arg]
+func (p *parser) restriction() (Expr, error) {
+	c, err := p.comparable()
+	if err != nil {
+		return nil, err
+	}
+	r := &Restriction{Comparable: c}
+	if p.peek().kind != tokenComparator {
+		return r, nil
+	}
+	r.Comparator = p.next().text
+	if r.Arg, err = p.arg(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// arg: comparable | composite
+func (p *parser) arg() (Expr, error) {
+	if p.peek().kind == tokenLParen {
+		return p.composite()
+	}
+	return p.comparable()
+}
+
+// comparable: member | function
+// member: value {DOT field}
+// function: name {DOT name} LPAREN [arg {COMMA arg}] RPAREN
+func (p *parser) comparable() (Expr, error) {
+	t := p.next()
+	if !isValue(t) || t.keyword("AND") || t.keyword("OR") || t.keyword("NOT") {
+		return nil, p.unexpected(t)
+	}
+	path := []string{t.text}
+	names := t.kind == tokenText
+	for p.peek().kind == tokenDot {
+		p.next()
+		// Fields may be keywords.
+		t := p.next()
+		if !isValue(t) {
+			return nil, p.unexpected(t)
+		}
+		path = append(path, t.text)
+		names = names && t.kind == tokenText
+	}
+	if !names || p.peek().kind != tokenLParen {
+		return &Member{Path: path}, nil
+	}
+	p.next()
+	f := &Function{Name: path}
+	if p.peek().kind == tokenRParen {
+		p.next()
+		return f, nil
+	}
+	for {
+		a, err := p.arg()
+		if err != nil {
+			return nil, err
+		}
+		f.Args = append(f.Args, a)
+		switch t := p.next(); t.kind {
+		case tokenComma:
+			continue
+		case tokenRParen:
+			return f, nil
+		default:
+			return nil, p.unexpected(t)
+		}
+	}
+}
+
+func isValue(t token) bool {
+	return t.kind == tokenText || t.kind == tokenString
+}
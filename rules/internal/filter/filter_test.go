@@ -0,0 +1,126 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package filter
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func member(path ...string) *Member {
+	return &Member{Path: path}
+}
+
+func restriction(comparable Expr, comparator string, arg Expr) *Restriction {
+	return &Restriction{Comparable: comparable, Comparator: comparator, Arg: arg}
+}
+
+func TestParse(t *testing.T) {
+	for _, test := range []struct {
+		name   string
+		filter string
+		want   Expr
+	}{
+		{"Empty", "  ", nil},
+		{"Global", "Tolkien", restriction(member("Tolkien"), "", nil)},
+		{"Equals", `author = "J. R. R. Tolkien"`, restriction(member("author"), "=", member("J. R. R. Tolkien"))},
+		{"NoSpaces", "rating>=4", restriction(member("rating"), ">=", member("4"))},
+		{"Traversal", "author.name != 'Tolkien'", restriction(member("author", "name"), "!=", member("Tolkien"))},
+		{"Has", "labels.env:*", restriction(member("labels", "env"), ":", member("*"))},
+		{"KeywordField", "a.AND = 1", restriction(member("a", "AND"), "=", member("1"))},
+		{"And", "a = 1 AND b < 2", &And{Exprs: []Expr{
+			restriction(member("a"), "=", member("1")),
+			restriction(member("b"), "<", member("2")),
+		}}},
+		{"Sequence", "a b", &And{Exprs: []Expr{
+			restriction(member("a"), "", nil),
+			restriction(member("b"), "", nil),
+		}}},
+		{"OrBindsTighter", "a OR b AND c", &And{Exprs: []Expr{
+			&Or{Exprs: []Expr{restriction(member("a"), "", nil), restriction(member("b"), "", nil)}},
+			restriction(member("c"), "", nil),
+		}}},
+		{"Not", "NOT a = 1", &Not{Expr: restriction(member("a"), "=", member("1"))}},
+		{"Minus", "-a = 1", &Not{Expr: restriction(member("a"), "=", member("1"))}},
+		{"MinusComposite", "-(a)", &Not{Expr: restriction(member("a"), "", nil)}},
+		{"NegativeNumber", "a > -1", restriction(member("a"), ">", member("-1"))},
+		{"Composite", "(a OR b) c", &And{Exprs: []Expr{
+			&Or{Exprs: []Expr{restriction(member("a"), "", nil), restriction(member("b"), "", nil)}},
+			restriction(member("c"), "", nil),
+		}}},
+		{"Function", "regex(name, '^a') = true", restriction(
+			&Function{Name: []string{"regex"}, Args: []Expr{member("name"), member("^a")}}, "=", member("true"))},
+		{"FunctionNoArgs", "a < time.now()", restriction(member("a"), "<", &Function{Name: []string{"time", "now"}})},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := Parse(test.filter)
+			if err != nil {
+				t.Fatalf("Parse(%q) returned error: %v", test.filter, err)
+			}
+			if diff := cmp.Diff(test.want, got); diff != "" {
+				t.Errorf("Parse(%q) got diff (-want +got):\n%s", test.filter, diff)
+			}
+		})
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, test := range []struct {
+		name       string
+		filter     string
+		wantOffset int
+	}{
+		{"MissingArg", "a =", 3},
+		{"DanglingAnd", "a AND", 5},
+		{"LeadingOr", "OR a", 0},
+		{"UnbalancedOpen", "(a = 1", 6},
+		{"UnbalancedClose", "a = 1)", 5},
+		{"Bang", "a ! b", 2},
+		{"UnterminatedString", `a = "b`, 4},
+		{"DoubleComparator", "a = = 1", 4},
+		{"TrailingDot", "a. = 1", 3},
+		{"UnclosedFunction", "f(a, b", 6},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := Parse(test.filter)
+			var syntaxErr *SyntaxError
+			if !errors.As(err, &syntaxErr) {
+				t.Fatalf("Parse(%q) returned %v; want a *SyntaxError", test.filter, err)
+			}
+			if syntaxErr.Offset != test.wantOffset {
+				t.Errorf("Parse(%q) returned error %q at offset %d; want offset %d", test.filter, err, syntaxErr.Offset, test.wantOffset)
+			}
+		})
+	}
+}
+
+func TestRestrictions(t *testing.T) {
+	e, err := Parse("a = 1 OR NOT (b.c > f((d = 2)))")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got [][]string
+	for _, r := range Restrictions(e) {
+		if m, ok := r.Comparable.(*Member); ok {
+			got = append(got, m.Path)
+		}
+	}
+	want := [][]string{{"a"}, {"b", "c"}, {"d"}}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Restrictions() got diff (-want +got):\n%s", diff)
+	}
+}
@@ -21,6 +21,7 @@ import (
 	"testing"
 	"text/template"
 
+	"github.com/googleapis/api-linter/internal"
 	"github.com/jhump/protoreflect/desc"
 	"github.com/jhump/protoreflect/desc/protoparse"
 	"github.com/lithammer/dedent"
@@ -47,10 +48,9 @@ func ParseProtoStrings(t *testing.T, src map[string]string) map[string]*desc.Fil
 	}
 
 	// Parse the file.
-	parser := protoparse.Parser{
-		Accessor:              protoparse.FileContentsFromMap(src),
-		IncludeSourceCodeInfo: true,
-		LookupImport:          desc.LoadFileDescriptor,
+	parser := internal.Parser{
+		Accessor:     protoparse.FileContentsFromMap(src),
+		LookupImport: desc.LoadFileDescriptor,
 	}
 	fds, err := parser.ParseFiles(filenames...)
 	if err != nil {
@@ -77,6 +77,20 @@ func ParseProto3String(t *testing.T, src string) *desc.FileDescriptor {
 	})["test.proto"]
 }
 
+// ParseEditionsString parses a string representing a proto file that uses
+// Protobuf Editions, and returns a FileDescriptor.
+//
+// It adds the `edition = "2023";` line to the beginning of the file and
+// chooses a filename, and then calls ParseProtoStrings.
+func ParseEditionsString(t *testing.T, src string) *desc.FileDescriptor {
+	return ParseProtoStrings(t, map[string]string{
+		"test.proto": fmt.Sprintf(
+			"edition = \"2023\";\n\n%s",
+			strings.TrimSpace(dedent.Dedent(src)),
+		),
+	})["test.proto"]
+}
+
 // ParseProto3Tmpl parses a template string representing a proto file, and
 // returns a FileDescriptor.
 //
@@ -14,7 +14,10 @@
 
 package utils
 
-import "strings"
+import (
+	"regexp"
+	"strings"
+)
 
 // SeparateInternalComments splits the given comment block into "external" and
 // "internal" comments based on https://google.aip.dev/192#internal-comments.
@@ -54,3 +57,21 @@ func SeparateInternalComments(comments ...string) struct {
 	}
 	return answer
 }
+
+var codeSpanRegexp = regexp.MustCompile("(?:^|[^`])`([^`]+)`")
+
+// GetCodeSpansAfter returns the Markdown code spans (in single backticks)
+// that follow the first match of intro in the given comment, such as the
+// examples introduced by "For example". Whitespace within each span is
+// collapsed. It returns nil if intro does not match.
+func GetCodeSpansAfter(comment string, intro *regexp.Regexp) []string {
+	loc := intro.FindStringIndex(comment)
+	if loc == nil {
+		return nil
+	}
+	var spans []string
+	for _, match := range codeSpanRegexp.FindAllStringSubmatch(comment[loc[1]:], -1) {
+		spans = append(spans, strings.Join(strings.Fields(match[1]), " "))
+	}
+	return spans
+}
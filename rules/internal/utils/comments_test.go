@@ -15,6 +15,7 @@
 package utils
 
 import (
+	"regexp"
 	"strings"
 	"testing"
 
@@ -62,3 +63,23 @@ func TestSeparateInternalComments(t *testing.T) {
 		})
 	}
 }
+
+func TestGetCodeSpansAfter(t *testing.T) {
+	intro := regexp.MustCompile(`(?i)\bexample\b`)
+	for _, tst := range []struct {
+		name    string
+		comment string
+		want    []string
+	}{
+		{"no intro", "Use `a` or `b`.", nil},
+		{"after intro", "Use `a`.\nFor example, `b` or `c`.", []string{"b", "c"}},
+		{"multiline span", "For example:\n`a AND\n  b`", []string{"a AND b"}},
+		{"no spans", "For example, nothing.", nil},
+	} {
+		t.Run(tst.name, func(t *testing.T) {
+			if diff := cmp.Diff(tst.want, GetCodeSpansAfter(tst.comment, intro)); diff != "" {
+				t.Errorf("GetCodeSpansAfter() got diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
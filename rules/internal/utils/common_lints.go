@@ -126,12 +126,12 @@ func LintFieldResourceReference(f *desc.FieldDescriptor) []lint.Problem {
 }
 
 func lintHTTPBody(m *desc.MethodDescriptor, want, msg string) []lint.Problem {
-	for _, httpRule := range GetHTTPRules(m) {
+	for i, httpRule := range GetHTTPRules(m) {
 		if httpRule.Body != want {
 			return []lint.Problem{{
 				Message:    fmt.Sprintf("The `%s` method should %s HTTP body.", m.GetName(), msg),
 				Descriptor: m,
-				Location:   locations.MethodHTTPRule(m),
+				Location:   locations.MethodHTTPRuleBody(m, i),
 			}}
 		}
 	}
@@ -151,12 +151,12 @@ func LintWildcardHTTPBody(m *desc.MethodDescriptor) []lint.Problem {
 // LintHTTPMethod returns a problem for each HTTP rule whose HTTP method is not the given one.
 func LintHTTPMethod(verb string) func(*desc.MethodDescriptor) []lint.Problem {
 	return func(m *desc.MethodDescriptor) []lint.Problem {
-		for _, httpRule := range GetHTTPRules(m) {
+		for i, httpRule := range GetHTTPRules(m) {
 			if httpRule.Method != verb {
 				return []lint.Problem{{
 					Message:    fmt.Sprintf("The `%s` method should use the HTTP %s verb.", m.GetName(), verb),
 					Descriptor: m,
-					Location:   locations.MethodHTTPRule(m),
+					Location:   locations.MethodHTTPRuleURI(m, i),
 				}}
 			}
 		}
@@ -217,12 +217,12 @@ func LintHTTPURIHasParentVariable(m *desc.MethodDescriptor) []lint.Problem {
 // LintHTTPURIHasVariable returns a problem if any of the given method's HTTP rules do not
 // have the given variable in the URI.
 func LintHTTPURIHasVariable(m *desc.MethodDescriptor, v string) []lint.Problem {
-	for _, httpRule := range GetHTTPRules(m) {
+	for i, httpRule := range GetHTTPRules(m) {
 		if _, ok := httpRule.GetVariables()[v]; !ok {
 			return []lint.Problem{{
 				Message:    fmt.Sprintf("HTTP URI should include a `%s` variable.", v),
 				Descriptor: m,
-				Location:   locations.MethodHTTPRule(m),
+				Location:   locations.MethodHTTPRuleURI(m, i),
 			}}
 		}
 	}
@@ -0,0 +1,57 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"github.com/jhump/protoreflect/desc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// IsEditions returns true if the file uses Protobuf Editions
+// (e.g. `edition = "2023";`) rather than the proto2 or proto3 syntax.
+func IsEditions(f *desc.FileDescriptor) bool {
+	return f.UnwrapFile().Syntax() == protoreflect.Editions
+}
+
+// HasExplicitPresence returns true if a singular, non-message field that is
+// not part of a oneof tracks presence. In proto3 files, these are the fields
+// labeled `optional`; in files using Protobuf Editions, the fields with
+// `features.field_presence = EXPLICIT`, which is the default in Edition 2023.
+//
+// Message fields and oneof members always track presence, and are not
+// reported.
+func HasExplicitPresence(f *desc.FieldDescriptor) bool {
+	if !IsEditions(f.GetFile()) {
+		return f.IsProto3Optional()
+	}
+	if f.IsRepeated() || f.GetMessageType() != nil || f.GetOneOf() != nil {
+		return false
+	}
+	return f.UnwrapField().HasPresence()
+}
+
+// IsClosedEnum returns true if values outside of the enum's declared values
+// are rejected: proto2 enums, and enums with `features.enum_type = CLOSED` in
+// files using Protobuf Editions. Proto3 enums are always open.
+func IsClosedEnum(e *desc.EnumDescriptor) bool {
+	return e.UnwrapEnum().IsClosed()
+}
+
+// IsDelimited returns true if the message field uses the delimited (group)
+// wire encoding: proto2 groups, and fields with
+// `features.message_encoding = DELIMITED` in files using Protobuf Editions.
+func IsDelimited(f *desc.FieldDescriptor) bool {
+	return f.UnwrapField().Kind() == protoreflect.GroupKind
+}
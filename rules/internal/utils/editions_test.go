@@ -0,0 +1,128 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"testing"
+
+	"github.com/googleapis/api-linter/rules/internal/testutils"
+)
+
+func TestIsEditions(t *testing.T) {
+	if IsEditions(testutils.ParseProto3String(t, "package test;")) {
+		t.Errorf("IsEditions should be false for proto3 files")
+	}
+	if !IsEditions(testutils.ParseEditionsString(t, "package test;")) {
+		t.Errorf("IsEditions should be true for editions files")
+	}
+}
+
+func TestHasExplicitPresence(t *testing.T) {
+	proto3 := testutils.ParseProto3String(t, `
+		message Book {
+			string name = 1;
+			optional string title = 2;
+			Book sequel = 3;
+		}
+	`).GetMessageTypes()[0]
+	editions := testutils.ParseEditionsString(t, `
+		message Book {
+			string name = 1 [features.field_presence = IMPLICIT];
+			string title = 2;
+			Book sequel = 3;
+			repeated string authors = 4;
+			oneof format {
+				string isbn = 5;
+			}
+		}
+	`).GetMessageTypes()[0]
+	implicit := testutils.ParseEditionsString(t, `
+		option features.field_presence = IMPLICIT;
+		message Book {
+			string name = 1;
+			string title = 2 [features.field_presence = EXPLICIT];
+		}
+	`).GetMessageTypes()[0]
+	for _, test := range []struct {
+		name string
+		got  bool
+		want bool
+	}{
+		{"Proto3Implicit", HasExplicitPresence(proto3.FindFieldByName("name")), false},
+		{"Proto3Optional", HasExplicitPresence(proto3.FindFieldByName("title")), true},
+		{"Proto3Message", HasExplicitPresence(proto3.FindFieldByName("sequel")), false},
+		{"EditionsImplicit", HasExplicitPresence(editions.FindFieldByName("name")), false},
+		{"EditionsDefault", HasExplicitPresence(editions.FindFieldByName("title")), true},
+		{"EditionsMessage", HasExplicitPresence(editions.FindFieldByName("sequel")), false},
+		{"EditionsRepeated", HasExplicitPresence(editions.FindFieldByName("authors")), false},
+		{"EditionsOneof", HasExplicitPresence(editions.FindFieldByName("isbn")), false},
+		{"FileDefaultImplicit", HasExplicitPresence(implicit.FindFieldByName("name")), false},
+		{"FieldOverridesFileDefault", HasExplicitPresence(implicit.FindFieldByName("title")), true},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			if test.got != test.want {
+				t.Errorf("HasExplicitPresence got %v; want %v", test.got, test.want)
+			}
+		})
+	}
+}
+
+func TestIsClosedEnum(t *testing.T) {
+	proto3 := testutils.ParseProto3String(t, `
+		enum Format {
+			FORMAT_UNSPECIFIED = 0;
+		}
+	`)
+	editions := testutils.ParseEditionsString(t, `
+		enum Format {
+			FORMAT_UNSPECIFIED = 0;
+		}
+		enum State {
+			option features.enum_type = CLOSED;
+			STATE_UNSPECIFIED = 0;
+		}
+	`)
+	for _, test := range []struct {
+		name string
+		got  bool
+		want bool
+	}{
+		{"Proto3", IsClosedEnum(proto3.GetEnumTypes()[0]), false},
+		{"EditionsOpen", IsClosedEnum(editions.GetEnumTypes()[0]), false},
+		{"EditionsClosed", IsClosedEnum(editions.GetEnumTypes()[1]), true},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			if test.got != test.want {
+				t.Errorf("IsClosedEnum got %v; want %v", test.got, test.want)
+			}
+		})
+	}
+}
+
+func TestIsDelimited(t *testing.T) {
+	m := testutils.ParseEditionsString(t, `
+		message Book {
+			Author author = 1 [features.message_encoding = DELIMITED];
+			Author editor = 2;
+		}
+		message Author {}
+	`).GetMessageTypes()[0]
+	if !IsDelimited(m.FindFieldByName("author")) {
+		t.Errorf("IsDelimited should be true for delimited fields")
+	}
+	if IsDelimited(m.FindFieldByName("editor")) {
+		t.Errorf("IsDelimited should be false for length-prefixed fields")
+	}
+}
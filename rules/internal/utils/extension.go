@@ -19,9 +19,12 @@ import (
 
 	"bitbucket.org/creachadair/stringset"
 	lrpb "cloud.google.com/go/longrunning/autogen/longrunningpb"
+	"github.com/googleapis/api-linter/lint"
+	"github.com/googleapis/api-linter/locations"
 	"github.com/jhump/protoreflect/desc"
 	apb "google.golang.org/genproto/googleapis/api/annotations"
 	"google.golang.org/protobuf/proto"
+	dpb "google.golang.org/protobuf/types/descriptorpb"
 )
 
 // GetFieldBehavior returns a stringset.Set of FieldBehavior annotations for
@@ -38,6 +41,19 @@ func GetFieldBehavior(f *desc.FieldDescriptor) stringset.Set {
 	return nil
 }
 
+// FieldBehaviorLocation returns the location of the given value (e.g.
+// "REQUIRED") of the field's field_behavior annotation. If the field does not
+// have that value, it returns the location of the whole annotation.
+func FieldBehaviorLocation(f *desc.FieldDescriptor, behavior string) *dpb.SourceCodeInfo_Location {
+	fbs, _ := proto.GetExtension(f.GetFieldOptions(), apb.E_FieldBehavior).([]apb.FieldBehavior)
+	for i, fb := range fbs {
+		if fb.String() == behavior {
+			return locations.FieldBehaviorValue(f, i)
+		}
+	}
+	return locations.FieldBehavior(f)
+}
+
 // GetOperationInfo returns the google.longrunning.operation_info annotation.
 func GetOperationInfo(m *desc.MethodDescriptor) *lrpb.OperationInfo {
 	if m == nil {
@@ -111,6 +127,18 @@ func GetMethodSignatures(m *desc.MethodDescriptor) [][]string {
 	return answer
 }
 
+// GetRouting returns the `google.api.routing` annotation.
+func GetRouting(m *desc.MethodDescriptor) *apb.RoutingRule {
+	if m == nil {
+		return nil
+	}
+	opts := m.GetMethodOptions()
+	if x := proto.GetExtension(opts, apb.E_Routing); x != nil {
+		return x.(*apb.RoutingRule)
+	}
+	return nil
+}
+
 // GetResource returns the google.api.resource annotation.
 func GetResource(m *desc.MessageDescriptor) *apb.ResourceDescriptor {
 	if m == nil {
@@ -132,6 +160,12 @@ func IsResource(m *desc.MessageDescriptor) bool {
 	return false
 }
 
+// HasResourcePatterns returns true if the message has a google.api.resource
+// annotation with at least one pattern.
+func HasResourcePatterns(m *desc.MessageDescriptor) bool {
+	return len(GetResource(m).GetPattern()) > 0
+}
+
 // IsSingletonResource returns true if the given message is a singleton
 // resource according to its pattern.
 func IsSingletonResource(m *desc.MessageDescriptor) bool {
@@ -184,23 +218,26 @@ func GetResourceReference(f *desc.FieldDescriptor) *apb.ResourceReference {
 	return nil
 }
 
-// FindResource returns first resource of type matching the reference param.
-// resource Type name being referenced. It looks within a given file and its
-// depenedencies, it cannot search within the entire protobuf package.
+// FindResource returns the resource of the type being referenced, whether it
+// is declared on a message or with a `google.api.resource_definition`. It
+// looks within every file of the lint run's FileSet (including descriptor
+// sets), or within the given file and its dependencies when the set is nil.
 // This is especially useful for resolving google.api.resource_reference
 // annotations.
-func FindResource(reference string, file *desc.FileDescriptor) *apb.ResourceDescriptor {
-	m := FindResourceMessage(reference, file)
-	return GetResource(m)
+func FindResource(reference string, file *desc.FileDescriptor, set *lint.FileSet) *apb.ResourceDescriptor {
+	if m := FindResourceMessage(reference, file, set); m != nil {
+		return GetResource(m)
+	}
+	return getResourceIndex(file, set).resources[reference]
 }
 
 // FindResourceMessage returns the message containing the first resource of type
-// matching the resource Type name being referenced. It looks within a given
-// file and its depenedencies, it cannot search within the entire protobuf
-// package. This is especially useful for resolving
-// google.api.resource_reference annotations to the message that owns a
-// resource.
-func FindResourceMessage(reference string, file *desc.FileDescriptor) *desc.MessageDescriptor {
+// matching the resource Type name being referenced. It looks within the given
+// file and its dependencies first, and then within every file of the lint
+// run's FileSet (including descriptor sets), if any. This is especially useful
+// for resolving google.api.resource_reference annotations to the message that
+// owns a resource.
+func FindResourceMessage(reference string, file *desc.FileDescriptor, set *lint.FileSet) *desc.MessageDescriptor {
 	files := append(file.GetDependencies(), file)
 	for _, f := range files {
 		for _, m := range f.GetMessageTypes() {
@@ -211,7 +248,7 @@ func FindResourceMessage(reference string, file *desc.FileDescriptor) *desc.Mess
 			}
 		}
 	}
-	return nil
+	return getResourceIndex(file, set).messages[reference]
 }
 
 // SplitResourceTypeName splits the `Resource.type` field into the service name
@@ -229,9 +266,11 @@ func SplitResourceTypeName(typ string) (service string, typeName string, ok bool
 	return
 }
 
-// FindResourceChildren attempts to search for other resources defined in the
-// package that are parented by the given resource.
-func FindResourceChildren(parent *apb.ResourceDescriptor, file *desc.FileDescriptor) []*apb.ResourceDescriptor {
+// FindResourceChildren attempts to search for other resources that are
+// parented by the given resource, whether they are declared on messages or
+// with `google.api.resource_definition`s, within every file of the lint run's
+// FileSet (or the given file and its dependencies when the set is nil).
+func FindResourceChildren(parent *apb.ResourceDescriptor, file *desc.FileDescriptor, set *lint.FileSet) []*apb.ResourceDescriptor {
 	pats := parent.GetPattern()
 	if len(pats) == 0 {
 		return nil
@@ -242,16 +281,14 @@ func FindResourceChildren(parent *apb.ResourceDescriptor, file *desc.FileDescrip
 	first := pats[0]
 
 	var children []*apb.ResourceDescriptor
-	files := append(file.GetDependencies(), file)
-	for _, f := range files {
-		for _, m := range f.GetMessageTypes() {
-			if r := GetResource(m); r != nil && r.GetType() != parent.GetType() {
-				for _, p := range r.GetPattern() {
-					if strings.HasPrefix(p, first) {
-						children = append(children, r)
-						break
-					}
-				}
+	for _, r := range getResourceIndex(file, set).ordered {
+		if r.GetType() == parent.GetType() {
+			continue
+		}
+		for _, p := range r.GetPattern() {
+			if strings.HasPrefix(p, first) {
+				children = append(children, r)
+				break
 			}
 		}
 	}
@@ -259,6 +296,40 @@ func FindResourceChildren(parent *apb.ResourceDescriptor, file *desc.FileDescrip
 	return children
 }
 
+// FindResourceParents returns the resources that may be the direct parent of
+// the given resource, according to its patterns, within every file of the lint
+// run's FileSet (or the given file and its dependencies when the set is nil).
+// This is especially useful for resolving `google.api.resource_reference`
+// annotations that use `child_type`.
+func FindResourceParents(child *apb.ResourceDescriptor, file *desc.FileDescriptor, set *lint.FileSet) []*apb.ResourceDescriptor {
+	parentPatterns := stringset.New()
+	for _, p := range child.GetPattern() {
+		// The parent pattern drops the trailing collection and resource ID
+		// segments, e.g. "publishers/{publisher}/books/{book}" has the parent
+		// "publishers/{publisher}".
+		if segs := strings.Split(p, "/"); len(segs) > 2 {
+			parentPatterns.Add(strings.Join(segs[:len(segs)-2], "/"))
+		}
+	}
+	if parentPatterns.Empty() {
+		return nil
+	}
+
+	var parents []*apb.ResourceDescriptor
+	for _, r := range getResourceIndex(file, set).ordered {
+		if r.GetType() == child.GetType() {
+			continue
+		}
+		for _, p := range r.GetPattern() {
+			if parentPatterns.Contains(p) {
+				parents = append(parents, r)
+				break
+			}
+		}
+	}
+	return parents
+}
+
 func HasFieldInfo(fd *desc.FieldDescriptor) bool {
 	return fd != nil && proto.HasExtension(fd.GetFieldOptions(), apb.E_FieldInfo)
 }
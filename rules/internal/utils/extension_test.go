@@ -19,6 +19,7 @@ import (
 
 	"bitbucket.org/creachadair/stringset"
 	"github.com/google/go-cmp/cmp"
+	"github.com/googleapis/api-linter/lint"
 	"github.com/googleapis/api-linter/rules/internal/testutils"
 	apb "google.golang.org/genproto/googleapis/api/annotations"
 	"google.golang.org/protobuf/proto"
@@ -57,6 +58,33 @@ func TestGetFieldBehavior(t *testing.T) {
 	}
 }
 
+func TestFieldBehaviorLocation(t *testing.T) {
+	fd := testutils.ParseProto3String(t, `
+		import "google/api/field_behavior.proto";
+
+		message Book {
+			string name = 1 [
+				(google.api.field_behavior) = IMMUTABLE,
+				(google.api.field_behavior) = OUTPUT_ONLY];
+		}
+	`)
+	f := fd.GetMessageTypes()[0].GetFields()[0]
+	for _, test := range []struct {
+		behavior string
+		want     []int32
+	}{
+		{"IMMUTABLE", []int32{6, 16, 55}},
+		{"OUTPUT_ONLY", []int32{7, 16, 57}},
+		{"REQUIRED", nil},
+	} {
+		t.Run(test.behavior, func(t *testing.T) {
+			if diff := cmp.Diff(FieldBehaviorLocation(f, test.behavior).GetSpan(), test.want); diff != "" {
+				t.Errorf(diff)
+			}
+		})
+	}
+}
+
 func TestGetMethodSignatures(t *testing.T) {
 	for _, test := range []struct {
 		name       string
@@ -127,6 +155,32 @@ func TestGetOperationInfoNone(t *testing.T) {
 	}
 }
 
+func TestGetRouting(t *testing.T) {
+	fd := testutils.ParseProto3String(t, `
+		import "google/api/routing.proto";
+		service Library {
+			rpc GetBook(GetBookRequest) returns (Book) {
+				option (google.api.routing) = {
+					routing_parameters { field: "name" path_template: "{shelf=shelves/*}/**" }
+				};
+			}
+			rpc ListBooks(ListBooksRequest) returns (ListBooksResponse);
+		}
+		message GetBookRequest {}
+		message Book {}
+		message ListBooksRequest {}
+		message ListBooksResponse {}
+	`)
+	methods := fd.GetServices()[0].GetMethods()
+	params := GetRouting(methods[0]).GetRoutingParameters()
+	if len(params) != 1 || params[0].GetField() != "name" || params[0].GetPathTemplate() != "{shelf=shelves/*}/**" {
+		t.Errorf("GetRouting got the routing parameters %v.", params)
+	}
+	if routing := GetRouting(methods[1]); routing != nil {
+		t.Errorf("Got %v, expected nil routing annotation.", routing)
+	}
+}
+
 func TestGetOperationInfoResponseType(t *testing.T) {
 	// Set up testing permutations.
 	tests := []struct {
@@ -245,6 +299,30 @@ func TestGetResource(t *testing.T) {
 	})
 }
 
+func TestHasResourcePatterns(t *testing.T) {
+	for _, test := range []struct {
+		name     string
+		Resource string
+		want     bool
+	}{
+		{"Patterns", `option (google.api.resource) = { type: "library.googleapis.com/Book" pattern: "books/{book}" };`, true},
+		{"NoPatterns", `option (google.api.resource) = { type: "library.googleapis.com/Book" };`, false},
+		{"NotResource", "", false},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			f := testutils.ParseProto3Tmpl(t, `
+				import "google/api/resource.proto";
+				message Book {
+					{{.Resource}}
+				}
+			`, test)
+			if got := HasResourcePatterns(f.GetMessageTypes()[0]); got != test.want {
+				t.Errorf("HasResourcePatterns() got %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
 func TestGetResourceDefinition(t *testing.T) {
 	t.Run("Zero", func(t *testing.T) {
 		f := testutils.ParseProto3String(t, `
@@ -339,6 +417,11 @@ func TestFindResource(t *testing.T) {
 			import "book.proto";
 			import "google/api/resource.proto";
 
+			option (google.api.resource_definition) = {
+				type: "library.googleapis.com/Publisher"
+				pattern: "publishers/{publisher}"
+			};
+
 			message Shelf {
 				option (google.api.resource) = {
 					type: "library.googleapis.com/Shelf"
@@ -358,10 +441,11 @@ func TestFindResource(t *testing.T) {
 	}{
 		{"local_reference", "library.googleapis.com/Shelf", false},
 		{"imported_reference", "library.googleapis.com/Book", false},
+		{"resource_definition", "library.googleapis.com/Publisher", false},
 		{"unresolvable", "foo.googleapis.com/Bar", true},
 	} {
 		t.Run(tst.name, func(t *testing.T) {
-			got := FindResource(tst.reference, files["shelf.proto"])
+			got := FindResource(tst.reference, files["shelf.proto"], nil)
 
 			if tst.notFound && got != nil {
 				t.Fatalf("Expected to not find the resource, but found %q", got.GetType())
@@ -422,7 +506,7 @@ func TestFindResourceMessage(t *testing.T) {
 		{"unresolvable", "foo.googleapis.com/Bar", "", true},
 	} {
 		t.Run(tst.name, func(t *testing.T) {
-			got := FindResourceMessage(tst.reference, files["shelf.proto"])
+			got := FindResourceMessage(tst.reference, files["shelf.proto"], nil)
 
 			if tst.notFound && got != nil {
 				t.Fatalf("Expected to not find the message, but found %q", got.GetName())
@@ -560,7 +644,24 @@ func TestFindResourceChildren(t *testing.T) {
 			"publishers/{publisher}/books/{book}/editions/{edition}",
 		},
 	}
+	author := &apb.ResourceDescriptor{
+		Type: "library.googleapis.com/Author",
+		Pattern: []string{
+			"publishers/{publisher}/authors/{author}",
+		},
+	}
 	files := testutils.ParseProtoStrings(t, map[string]string{
+		"author.proto": `
+			syntax = "proto3";
+			package test;
+
+			import "google/api/resource.proto";
+
+			option (google.api.resource_definition) = {
+				type: "library.googleapis.com/Author"
+				pattern: "publishers/{publisher}/authors/{author}"
+			};
+		`,
 		"book.proto": `
 			syntax = "proto3";
 			package test;
@@ -605,17 +706,85 @@ func TestFindResourceChildren(t *testing.T) {
 		`,
 	})
 
+	// The FileSet holds author.proto, which shelf.proto does not import.
+	set := lint.NewFileSet(files["shelf.proto"], files["author.proto"])
 	for _, tst := range []struct {
 		name   string
 		parent *apb.ResourceDescriptor
+		set    *lint.FileSet
 		want   []*apb.ResourceDescriptor
 	}{
-		{"has_child_same_file", book, []*apb.ResourceDescriptor{edition}},
-		{"has_child_other_file", publisher, []*apb.ResourceDescriptor{book, edition}},
-		{"no_children", shelf, nil},
+		{"has_child_same_file", book, nil, []*apb.ResourceDescriptor{edition}},
+		{"has_child_other_file", publisher, nil, []*apb.ResourceDescriptor{book, edition}},
+		{"has_child_in_file_set", publisher, set, []*apb.ResourceDescriptor{book, edition, author}},
+		{"no_children", shelf, nil, nil},
+		{"no_children_in_file_set", shelf, set, nil},
+	} {
+		t.Run(tst.name, func(t *testing.T) {
+			got := FindResourceChildren(tst.parent, files["shelf.proto"], tst.set)
+			if diff := cmp.Diff(tst.want, got, cmp.Comparer(proto.Equal)); diff != "" {
+				t.Errorf("got(-),want(+):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFindResourceParents(t *testing.T) {
+	publisher := &apb.ResourceDescriptor{
+		Type:    "library.googleapis.com/Publisher",
+		Pattern: []string{"publishers/{publisher}"},
+	}
+	shelf := &apb.ResourceDescriptor{
+		Type:    "library.googleapis.com/Shelf",
+		Pattern: []string{"shelves/{shelf}"},
+	}
+	book := &apb.ResourceDescriptor{
+		Type: "library.googleapis.com/Book",
+		Pattern: []string{
+			"publishers/{publisher}/books/{book}",
+			"shelves/{shelf}/books/{book}",
+		},
+	}
+	file := testutils.ParseProto3String(t, `
+		package test;
+
+		import "google/api/resource.proto";
+
+		option (google.api.resource_definition) = {
+			type: "library.googleapis.com/Publisher"
+			pattern: "publishers/{publisher}"
+		};
+
+		message Shelf {
+			option (google.api.resource) = {
+				type: "library.googleapis.com/Shelf"
+				pattern: "shelves/{shelf}"
+			};
+
+			string name = 1;
+		}
+
+		message Book {
+			option (google.api.resource) = {
+				type: "library.googleapis.com/Book"
+				pattern: "publishers/{publisher}/books/{book}"
+				pattern: "shelves/{shelf}/books/{book}"
+			};
+
+			string name = 1;
+		}
+	`)
+
+	for _, tst := range []struct {
+		name  string
+		child *apb.ResourceDescriptor
+		want  []*apb.ResourceDescriptor
+	}{
+		{"multiple_parents", book, []*apb.ResourceDescriptor{shelf, publisher}},
+		{"top_level", publisher, nil},
 	} {
 		t.Run(tst.name, func(t *testing.T) {
-			got := FindResourceChildren(tst.parent, files["shelf.proto"])
+			got := FindResourceParents(tst.child, file, nil)
 			if diff := cmp.Diff(tst.want, got, cmp.Comparer(proto.Equal)); diff != "" {
 				t.Errorf("got(-),want(+):\n%s", diff)
 			}
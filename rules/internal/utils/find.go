@@ -141,12 +141,14 @@ func FindFieldDotNotation(msg *desc.MessageDescriptor, ref string) *desc.FieldDe
 			return nil
 		}
 
-		if m := field.GetMessageType(); m != nil && i != end {
-			msg = m
-			continue
+		if i == end {
+			return field
 		}
 
-		return field
+		// Only message fields can be traversed.
+		if msg = field.GetMessageType(); msg == nil {
+			return nil
+		}
 	}
 
 	return nil
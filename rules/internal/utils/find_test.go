@@ -89,4 +89,11 @@ func TestFindFieldDotNotation(t *testing.T) {
 		})
 	}
 
+	for _, path := range []string{"book.title", "parent.name", "book.publishing_info.publisher.name"} {
+		t.Run(path, func(t *testing.T) {
+			if f := FindFieldDotNotation(msg, path); f != nil {
+				t.Errorf("Got %q, expected nil", f.GetFullyQualifiedName())
+			}
+		})
+	}
 }
@@ -35,6 +35,10 @@ var (
 	deleteRevisionMethodRegexp       = regexp.MustCompile(`^Delete([A-Za-z0-9]+)Revision$`)
 	rollbackRevisionMethodRegexp     = regexp.MustCompile(`^Rollback([A-Za-z0-9]+)$`)
 	tagRevisionMethodRegexp          = regexp.MustCompile(`^Tag([A-Za-z0-9]+)Revision$`)
+
+	// AIP-153 Import and export methods
+	importMethodRegexp = regexp.MustCompile(`^Import([A-Z][A-Za-z0-9]*)$`)
+	exportMethodRegexp = regexp.MustCompile(`^Export([A-Z][A-Za-z0-9]*)$`)
 )
 
 // IsCreateMethod returns true if this is a AIP-133 Create method.
@@ -188,3 +192,15 @@ func ExtractRevisionResource(m *desc.MethodDescriptor) (string, bool) {
 
 	return "", false
 }
+
+// IsImportMethod returns true if this is an AIP-153 Import method, such as
+// `ImportBooks`.
+func IsImportMethod(m *desc.MethodDescriptor) bool {
+	return importMethodRegexp.MatchString(m.GetName())
+}
+
+// IsExportMethod returns true if this is an AIP-153 Export method, such as
+// `ExportBooks`.
+func IsExportMethod(m *desc.MethodDescriptor) bool {
+	return exportMethodRegexp.MatchString(m.GetName())
+}
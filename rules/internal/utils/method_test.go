@@ -498,3 +498,32 @@ func TestExtractRevisionResource(t *testing.T) {
 		})
 	}
 }
+
+func TestIsImportExportMethod(t *testing.T) {
+	for _, test := range []struct {
+		name       string
+		MethodName string
+		want       bool
+		is         func(m *desc.MethodDescriptor) bool
+	}{
+		{"IsImportMethod", "ImportBooks", true, IsImportMethod},
+		{"IsExportMethod", "ExportBooks", true, IsExportMethod},
+		{"NotImportMethod", "ImportantBooks", false, IsImportMethod},
+		{"NotExportMethodBare", "Export", false, IsExportMethod},
+		{"NotExportMethod", "GetBook", false, IsExportMethod},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			file := testutils.ParseProto3Tmpl(t, `
+				service Foo {
+					rpc {{.MethodName}}(Book) returns (Book);
+				}
+
+				message Book {}
+			`, test)
+			method := file.GetServices()[0].GetMethods()[0]
+			if got := test.is(method); got != test.want {
+				t.Errorf("got %v want %v", got, test.want)
+			}
+		})
+	}
+}
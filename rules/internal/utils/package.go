@@ -0,0 +1,27 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import "regexp"
+
+// versionRegexp matches the version component of a package, such as "v1" or
+// "v1p2beta1".
+var versionRegexp = regexp.MustCompile(`(^|\.)v[\d]+(p[\d]+)?(alpha|beta|eap|test)?[\d]*(\.|$)`)
+
+// IsVersionedPackage returns true if the package has a version component, as
+// in "google.example.library.v1".
+func IsVersionedPackage(pkg string) bool {
+	return versionRegexp.MatchString(pkg)
+}
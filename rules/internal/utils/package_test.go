@@ -0,0 +1,39 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import "testing"
+
+func TestIsVersionedPackage(t *testing.T) {
+	for _, test := range []struct {
+		pkg  string
+		want bool
+	}{
+		{"google.example.library.v1", true},
+		{"google.example.library.v1beta1", true},
+		{"google.example.library.v1p2alpha", true},
+		{"google.example.v2.library", true},
+		{"google.example.library", false},
+		{"google.example.video", false},
+		{"google.example.library.v1x", false},
+		{"v1", true},
+	} {
+		t.Run(test.pkg, func(t *testing.T) {
+			if got := IsVersionedPackage(test.pkg); got != test.want {
+				t.Errorf("IsVersionedPackage(%q) = %t, want %t", test.pkg, got, test.want)
+			}
+		})
+	}
+}
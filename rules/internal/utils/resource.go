@@ -90,3 +90,28 @@ func IsRevisionRelationship(parent, revision *apb.ResourceDescriptor) bool {
 	rType = strings.TrimSuffix(rType, "Revision")
 	return pType == rType
 }
+
+// ReplacePatternSegments returns the resource pattern with every collection
+// segment for which replace returns true, along with the variable that
+// follows it, replaced by the given collection and variable. It also reports
+// whether anything was replaced.
+//
+// For example, replacing "regions" in "projects/{project}/regions/{region}"
+// by "locations" and "location" returns
+// "projects/{project}/locations/{location}".
+func ReplacePatternSegments(pattern string, replace func(collection, variable string) bool, collection, variable string) (string, bool) {
+	segments := strings.Split(pattern, "/")
+	var replaced bool
+	for i := 0; i+1 < len(segments); i++ {
+		v := segments[i+1]
+		if !strings.HasPrefix(v, "{") || !strings.HasSuffix(v, "}") {
+			continue
+		}
+		if replace(segments[i], strings.Trim(v, "{}")) {
+			segments[i] = collection
+			segments[i+1] = "{" + variable + "}"
+			replaced = true
+		}
+	}
+	return strings.Join(segments, "/"), replaced
+}
@@ -0,0 +1,91 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"sort"
+
+	"github.com/googleapis/api-linter/lint"
+	"github.com/jhump/protoreflect/desc"
+	apb "google.golang.org/genproto/googleapis/api/annotations"
+)
+
+// resourceIndex maps resource types to their definitions across a set of
+// files.
+type resourceIndex struct {
+	// messages maps a resource type to the message annotated with it.
+	messages map[string]*desc.MessageDescriptor
+	// resources maps a resource type to its definition, whether it comes
+	// from a message or a `google.api.resource_definition`.
+	resources map[string]*apb.ResourceDescriptor
+	// ordered holds every resource in the order it was found.
+	ordered []*apb.ResourceDescriptor
+}
+
+type resourceIndexKey struct{}
+
+func newResourceIndex(files []*desc.FileDescriptor) *resourceIndex {
+	idx := &resourceIndex{
+		messages:  map[string]*desc.MessageDescriptor{},
+		resources: map[string]*apb.ResourceDescriptor{},
+	}
+	add := func(r *apb.ResourceDescriptor) bool {
+		if _, found := idx.resources[r.GetType()]; found || r.GetType() == "" {
+			return false
+		}
+		idx.resources[r.GetType()] = r
+		idx.ordered = append(idx.ordered, r)
+		return true
+	}
+	for _, f := range files {
+		for _, m := range lint.GetAllMessages(f) {
+			if r := GetResource(m); r != nil && add(r) {
+				idx.messages[r.GetType()] = m
+			}
+		}
+		for _, r := range GetResourceDefinitions(f) {
+			add(r)
+		}
+	}
+	return idx
+}
+
+// getResourceIndex returns the resource index for the given file.
+//
+// With the FileSet of a lint run, the index covers the whole run (every linted
+// file, every descriptor set, and their dependencies) and is built only once.
+// Otherwise, it covers the file and its transitive dependencies.
+func getResourceIndex(file *desc.FileDescriptor, set *lint.FileSet) *resourceIndex {
+	if set != nil {
+		return set.Value(resourceIndexKey{}, func(files []*desc.FileDescriptor) interface{} {
+			return newResourceIndex(files)
+		}).(*resourceIndex)
+	}
+	// Visit the dependencies in name order, so that the first definition of
+	// a resource type does not depend on map order.
+	deps := GetAllDependencies(file)
+	names := make([]string, 0, len(deps))
+	for name := range deps {
+		if name != file.GetName() {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	files := []*desc.FileDescriptor{file}
+	for _, name := range names {
+		files = append(files, deps[name])
+	}
+	return newResourceIndex(files)
+}
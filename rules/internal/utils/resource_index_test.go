@@ -0,0 +1,61 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"testing"
+
+	"github.com/googleapis/api-linter/rules/internal/testutils"
+)
+
+func TestGetResourceIndex_DependencyOrder(t *testing.T) {
+	definition := func(pattern string) string {
+		return `
+			syntax = "proto3";
+			package test;
+
+			import "google/api/resource.proto";
+
+			option (google.api.resource_definition) = {
+				type: "library.googleapis.com/Publisher"
+				pattern: "` + pattern + `"
+			};
+		`
+	}
+	files := testutils.ParseProtoStrings(t, map[string]string{
+		"z.proto": definition("z/{publisher}"),
+		"a.proto": definition("a/{publisher}"),
+		"m.proto": `
+			syntax = "proto3";
+			package test;
+
+			import "z.proto";
+			import "a.proto";
+		`,
+		"top.proto": `
+			syntax = "proto3";
+			package test;
+
+			import "m.proto";
+		`,
+	})
+	// The dependencies are visited in name order, whatever the map order.
+	for i := 0; i < 20; i++ {
+		got := getResourceIndex(files["top.proto"], nil).resources["library.googleapis.com/Publisher"]
+		if pattern := got.GetPattern(); len(pattern) != 1 || pattern[0] != "a/{publisher}" {
+			t.Fatalf("Got patterns %v; want the definition of a.proto", pattern)
+		}
+	}
+}
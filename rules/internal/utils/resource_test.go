@@ -181,3 +181,23 @@ func TestIsRevisionRelationship(t *testing.T) {
 		})
 	}
 }
+
+func TestReplacePatternSegments(t *testing.T) {
+	for _, test := range []struct {
+		pattern      string
+		want         string
+		wantReplaced bool
+	}{
+		{"projects/{project}/regions/{region}/books/{book}", "projects/{project}/locations/{location}/books/{book}", true},
+		{"projects/{project}/zones/{zone}", "projects/{project}/locations/{location}", true},
+		{"projects/{project}/books/{book}", "projects/{project}/books/{book}", false},
+		{"projects/{project}/regions", "projects/{project}/regions", false},
+	} {
+		got, replaced := ReplacePatternSegments(test.pattern, func(collection, _ string) bool {
+			return collection == "regions" || collection == "zones"
+		}, "locations", "location")
+		if got != test.want || replaced != test.wantReplaced {
+			t.Errorf("ReplacePatternSegments(%q) = %q, %t; want %q, %t", test.pattern, got, replaced, test.want, test.wantReplaced)
+		}
+	}
+}
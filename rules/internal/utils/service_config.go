@@ -0,0 +1,42 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"github.com/googleapis/api-linter/lint"
+	"github.com/jhump/protoreflect/desc"
+	apb "google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/genproto/googleapis/api/serviceconfig"
+)
+
+// GetServiceConfig returns the service config of the lint run's FileSet, or
+// nil if no service config was given (or the set is nil).
+func GetServiceConfig(set *lint.FileSet) *serviceconfig.Service {
+	if set != nil {
+		return set.ServiceConfig()
+	}
+	return nil
+}
+
+// GetMethodSettings returns the `publishing.method_settings` entry of the
+// service config for the given method, or nil if there is none.
+func GetMethodSettings(cfg *serviceconfig.Service, m *desc.MethodDescriptor) *apb.MethodSettings {
+	for _, s := range cfg.GetPublishing().GetMethodSettings() {
+		if s.GetSelector() == m.GetFullyQualifiedName() {
+			return s
+		}
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"testing"
+
+	"github.com/googleapis/api-linter/lint"
+	"github.com/googleapis/api-linter/rules/internal/testutils"
+	apb "google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/genproto/googleapis/api/serviceconfig"
+)
+
+func TestGetMethodSettings(t *testing.T) {
+	f := testutils.ParseProto3String(t, `
+		package test;
+		service Library {
+			rpc CreateBook(CreateBookRequest) returns (Book);
+			rpc DeleteBook(DeleteBookRequest) returns (Book);
+		}
+		message CreateBookRequest {}
+		message DeleteBookRequest {}
+		message Book {}
+	`)
+	cfg := &serviceconfig.Service{
+		Publishing: &apb.Publishing{
+			MethodSettings: []*apb.MethodSettings{
+				{Selector: "test.Library.CreateBook", AutoPopulatedFields: []string{"request_id"}},
+			},
+		},
+	}
+	methods := f.GetServices()[0].GetMethods()
+	if got := GetMethodSettings(cfg, methods[0]); got.GetSelector() != "test.Library.CreateBook" {
+		t.Errorf("GetMethodSettings(CreateBook) got %v", got)
+	}
+	if got := GetMethodSettings(cfg, methods[1]); got != nil {
+		t.Errorf("GetMethodSettings(DeleteBook) got %v, want nil", got)
+	}
+	if got := GetMethodSettings(nil, methods[0]); got != nil {
+		t.Errorf("GetMethodSettings without a service config got %v, want nil", got)
+	}
+	if got := GetServiceConfig(nil); got != nil {
+		t.Errorf("GetServiceConfig without a FileSet got %v, want nil", got)
+	}
+	if got := GetServiceConfig(lint.NewFileSet(f)); got != nil {
+		t.Errorf("GetServiceConfig without a service config got %v, want nil", got)
+	}
+}
@@ -0,0 +1,185 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rules
+
+import (
+	"sort"
+
+	"bitbucket.org/creachadair/stringset"
+	"github.com/googleapis/api-linter/internal/resourcegraph"
+	"github.com/googleapis/api-linter/lint"
+	"github.com/googleapis/api-linter/rules/internal/utils"
+	"github.com/jhump/protoreflect/desc"
+	apb "google.golang.org/genproto/googleapis/api/annotations"
+)
+
+// ResourceGraph builds the resource hierarchy of the given files from their
+// `google.api.resource` and `google.api.resource_definition` annotations, as
+// printed by the `api-linter resources` command.
+//
+// Resources are collected from the files themselves, not their dependencies,
+// but parent/child relationships and references may point to resources
+// declared in dependencies.
+func ResourceGraph(files ...*desc.FileDescriptor) *resourcegraph.Graph {
+	g := &resourcegraph.Graph{
+		Resources: []*resourcegraph.Resource{},
+		Edges:     []*resourcegraph.Edge{},
+	}
+	type decl struct {
+		resource *apb.ResourceDescriptor
+		file     *desc.FileDescriptor
+	}
+	nodes := map[string]*resourcegraph.Resource{}
+	var decls []decl
+	addNode := func(r *apb.ResourceDescriptor, f *desc.FileDescriptor, m *desc.MessageDescriptor) {
+		if _, ok := nodes[r.GetType()]; ok || r.GetType() == "" {
+			return
+		}
+		n := &resourcegraph.Resource{
+			Type:     r.GetType(),
+			Patterns: r.GetPattern(),
+			File:     f.GetName(),
+		}
+		if m != nil {
+			n.Message = m.GetFullyQualifiedName()
+		}
+		nodes[n.Type] = n
+		decls = append(decls, decl{r, f})
+		g.Resources = append(g.Resources, n)
+	}
+
+	// Collect every resource declared in the given files.
+	for _, f := range files {
+		for _, r := range utils.GetResourceDefinitions(f) {
+			addNode(r, f, nil)
+		}
+		for _, m := range lint.GetAllMessages(f) {
+			if utils.IsResource(m) {
+				addNode(utils.GetResource(m), f, m)
+			}
+		}
+	}
+
+	// Add the parent/child edges, as derived from the resource patterns.
+	seen := map[resourcegraph.Edge]bool{}
+	addEdge := func(e resourcegraph.Edge) {
+		if seen[e] {
+			return
+		}
+		seen[e] = true
+		g.Edges = append(g.Edges, &e)
+	}
+	set := lint.NewFileSet(files...)
+	for _, d := range decls {
+		for _, child := range directChildren(d.resource, d.file, set) {
+			addEdge(resourcegraph.Edge{From: d.resource.GetType(), To: child.GetType(), Kind: resourcegraph.Parent})
+		}
+	}
+
+	// Add the references made by the fields of each resource message.
+	for _, f := range files {
+		for _, m := range lint.GetAllMessages(f) {
+			if !utils.IsResource(m) {
+				continue
+			}
+			from := utils.GetResource(m).GetType()
+			for _, field := range m.GetFields() {
+				ref := utils.GetResourceReference(field)
+				if ref == nil {
+					continue
+				}
+				e := resourcegraph.Edge{From: from, To: ref.GetType(), Kind: resourcegraph.Reference, Field: field.GetFullyQualifiedName()}
+				if ref.GetChildType() != "" {
+					e.To, e.Kind = ref.GetChildType(), resourcegraph.ChildReference
+				}
+				if e.To != "" && e.To != "*" {
+					addEdge(e)
+				}
+			}
+		}
+	}
+
+	// Attach the standard methods to the resources they operate on. If
+	// several methods of a kind operate on a resource, the first one is kept.
+	for _, f := range files {
+		for _, s := range f.GetServices() {
+			for _, m := range s.GetMethods() {
+				kind, typ := standardMethod(m)
+				n, ok := nodes[typ]
+				if !ok || kind == "" {
+					continue
+				}
+				if n.Methods == nil {
+					n.Methods = map[string]string{}
+				}
+				if _, found := n.Methods[kind]; !found {
+					n.Methods[kind] = m.GetFullyQualifiedName()
+				}
+			}
+		}
+	}
+
+	sort.Slice(g.Resources, func(i, j int) bool {
+		return g.Resources[i].Type < g.Resources[j].Type
+	})
+	sort.SliceStable(g.Edges, func(i, j int) bool {
+		a, b := g.Edges[i], g.Edges[j]
+		if a.From != b.From {
+			return a.From < b.From
+		}
+		if a.To != b.To {
+			return a.To < b.To
+		}
+		return a.Kind < b.Kind
+	})
+	return g
+}
+
+// directChildren returns the children of the resource in the FileSet,
+// omitting grandchildren and deeper descendants.
+func directChildren(parent *apb.ResourceDescriptor, f *desc.FileDescriptor, set *lint.FileSet) []*apb.ResourceDescriptor {
+	children := utils.FindResourceChildren(parent, f, set)
+	descendants := stringset.New()
+	for _, c := range children {
+		for _, d := range utils.FindResourceChildren(c, f, set) {
+			descendants.Add(d.GetType())
+		}
+	}
+	var direct []*apb.ResourceDescriptor
+	for _, c := range children {
+		if !descendants.Contains(c.GetType()) {
+			direct = append(direct, c)
+		}
+	}
+	return direct
+}
+
+// standardMethod returns the kind of standard method and the type of the
+// resource it operates on, or empty strings if it is not a standard method.
+func standardMethod(m *desc.MethodDescriptor) (kind string, resourceType string) {
+	switch {
+	case utils.IsGetMethod(m):
+		return "Get", utils.GetResource(utils.GetResponseType(m)).GetType()
+	case utils.IsListMethod(m):
+		return "List", utils.GetResource(utils.GetListResourceMessage(m)).GetType()
+	case utils.IsCreateMethod(m):
+		return "Create", utils.GetResource(utils.GetResponseType(m)).GetType()
+	case utils.IsUpdateMethod(m):
+		return "Update", utils.GetResource(utils.GetResponseType(m)).GetType()
+	case utils.IsDeleteMethod(m):
+		return "Delete", utils.GetResourceReference(m.GetInputType().FindFieldByName("name")).GetType()
+	}
+	return "", ""
+}
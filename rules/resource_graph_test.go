@@ -0,0 +1,150 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// 		https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rules
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/googleapis/api-linter/internal/resourcegraph"
+	"github.com/googleapis/api-linter/rules/internal/testutils"
+)
+
+const library = `
+	syntax = "proto3";
+	import "google/api/resource.proto";
+	import "google/longrunning/operations.proto";
+	package test;
+
+	option (google.api.resource_definition) = {
+		type: "library.googleapis.com/Publisher"
+		pattern: "publishers/{publisher}"
+	};
+
+	service Library {
+		rpc GetBook(GetBookRequest) returns (Book);
+		rpc ListBooks(ListBooksRequest) returns (ListBooksResponse);
+		rpc CreateBook(CreateBookRequest) returns (google.longrunning.Operation) {
+			option (google.longrunning.operation_info) = {
+				response_type: "Book"
+				metadata_type: "Book"
+			};
+		}
+		rpc DeleteBook(DeleteBookRequest) returns (Book);
+		rpc GetAuthor(GetAuthorRequest) returns (Author);
+	}
+
+	message Book {
+		option (google.api.resource) = {
+			type: "library.googleapis.com/Book"
+			pattern: "publishers/{publisher}/books/{book}"
+		};
+		string name = 1;
+		string author = 2 [(google.api.resource_reference).type = "library.googleapis.com/Author"];
+		string shelf = 3 [(google.api.resource_reference).child_type = "library.googleapis.com/Shelf"];
+	}
+
+	message Edition {
+		option (google.api.resource) = {
+			type: "library.googleapis.com/Edition"
+			pattern: "publishers/{publisher}/books/{book}/editions/{edition}"
+		};
+		string name = 1;
+	}
+
+	message Author {
+		option (google.api.resource) = {
+			type: "library.googleapis.com/Author"
+			pattern: "authors/{author}"
+		};
+		string name = 1;
+	}
+
+	message GetBookRequest {
+		string name = 1;
+	}
+
+	message ListBooksRequest {
+		string parent = 1;
+	}
+
+	message ListBooksResponse {
+		repeated Book books = 1;
+	}
+
+	message CreateBookRequest {
+		string parent = 1;
+		Book book = 2;
+	}
+
+	message DeleteBookRequest {
+		string name = 1 [(google.api.resource_reference).type = "library.googleapis.com/Book"];
+	}
+
+	message GetAuthorRequest {
+		string name = 1;
+	}
+`
+
+func TestResourceGraph(t *testing.T) {
+	f := testutils.ParseProtoStrings(t, map[string]string{"test.proto": library})["test.proto"]
+	g := ResourceGraph(f)
+
+	wantEdges := []*resourcegraph.Edge{
+		{From: "library.googleapis.com/Book", To: "library.googleapis.com/Author", Kind: resourcegraph.Reference, Field: "test.Book.author"},
+		{From: "library.googleapis.com/Book", To: "library.googleapis.com/Edition", Kind: resourcegraph.Parent},
+		{From: "library.googleapis.com/Book", To: "library.googleapis.com/Shelf", Kind: resourcegraph.ChildReference, Field: "test.Book.shelf"},
+		{From: "library.googleapis.com/Publisher", To: "library.googleapis.com/Book", Kind: resourcegraph.Parent},
+	}
+	if diff := cmp.Diff(wantEdges, g.Edges); diff != "" {
+		t.Errorf("Edges mismatch (-want +got):\n%s", diff)
+	}
+
+	wantResources := []*resourcegraph.Resource{
+		{
+			Type:     "library.googleapis.com/Author",
+			Patterns: []string{"authors/{author}"},
+			Message:  "test.Author",
+			File:     "test.proto",
+			Methods:  map[string]string{"Get": "test.Library.GetAuthor"},
+		},
+		{
+			Type:     "library.googleapis.com/Book",
+			Patterns: []string{"publishers/{publisher}/books/{book}"},
+			Message:  "test.Book",
+			File:     "test.proto",
+			Methods: map[string]string{
+				"Get":    "test.Library.GetBook",
+				"List":   "test.Library.ListBooks",
+				"Create": "test.Library.CreateBook",
+				"Delete": "test.Library.DeleteBook",
+			},
+		},
+		{
+			Type:     "library.googleapis.com/Edition",
+			Patterns: []string{"publishers/{publisher}/books/{book}/editions/{edition}"},
+			Message:  "test.Edition",
+			File:     "test.proto",
+		},
+		{
+			Type:     "library.googleapis.com/Publisher",
+			Patterns: []string{"publishers/{publisher}"},
+			File:     "test.proto",
+		},
+	}
+	if diff := cmp.Diff(wantResources, g.Resources); diff != "" {
+		t.Errorf("Resources mismatch (-want +got):\n%s", diff)
+	}
+}
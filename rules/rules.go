@@ -69,24 +69,32 @@ import (
 	"github.com/googleapis/api-linter/rules/aip0142"
 	"github.com/googleapis/api-linter/rules/aip0143"
 	"github.com/googleapis/api-linter/rules/aip0144"
+	"github.com/googleapis/api-linter/rules/aip0145"
 	"github.com/googleapis/api-linter/rules/aip0146"
+	"github.com/googleapis/api-linter/rules/aip0147"
 	"github.com/googleapis/api-linter/rules/aip0148"
+	"github.com/googleapis/api-linter/rules/aip0149"
 	"github.com/googleapis/api-linter/rules/aip0151"
 	"github.com/googleapis/api-linter/rules/aip0152"
+	"github.com/googleapis/api-linter/rules/aip0153"
 	"github.com/googleapis/api-linter/rules/aip0154"
 	"github.com/googleapis/api-linter/rules/aip0155"
 	"github.com/googleapis/api-linter/rules/aip0156"
 	"github.com/googleapis/api-linter/rules/aip0157"
 	"github.com/googleapis/api-linter/rules/aip0158"
 	"github.com/googleapis/api-linter/rules/aip0159"
+	"github.com/googleapis/api-linter/rules/aip0160"
+	"github.com/googleapis/api-linter/rules/aip0161"
 	"github.com/googleapis/api-linter/rules/aip0162"
 	"github.com/googleapis/api-linter/rules/aip0163"
 	"github.com/googleapis/api-linter/rules/aip0164"
 	"github.com/googleapis/api-linter/rules/aip0165"
 	"github.com/googleapis/api-linter/rules/aip0191"
 	"github.com/googleapis/api-linter/rules/aip0192"
+	"github.com/googleapis/api-linter/rules/aip0193"
 	"github.com/googleapis/api-linter/rules/aip0202"
 	"github.com/googleapis/api-linter/rules/aip0203"
+	"github.com/googleapis/api-linter/rules/aip0213"
 	"github.com/googleapis/api-linter/rules/aip0214"
 	"github.com/googleapis/api-linter/rules/aip0215"
 	"github.com/googleapis/api-linter/rules/aip0216"
@@ -95,7 +103,11 @@ import (
 	"github.com/googleapis/api-linter/rules/aip0233"
 	"github.com/googleapis/api-linter/rules/aip0234"
 	"github.com/googleapis/api-linter/rules/aip0235"
+	"github.com/googleapis/api-linter/rules/aip2500"
+	"github.com/googleapis/api-linter/rules/aip2510"
+	"github.com/googleapis/api-linter/rules/aip4222"
 	"github.com/googleapis/api-linter/rules/aip4232"
+	"github.com/googleapis/api-linter/rules/aip4235"
 )
 
 type addRulesFuncType func(lint.RuleRegistry) error
@@ -119,24 +131,32 @@ var aipAddRulesFuncs = []addRulesFuncType{
 	aip0142.AddRules,
 	aip0143.AddRules,
 	aip0144.AddRules,
+	aip0145.AddRules,
 	aip0146.AddRules,
+	aip0147.AddRules,
 	aip0148.AddRules,
+	aip0149.AddRules,
 	aip0151.AddRules,
 	aip0152.AddRules,
+	aip0153.AddRules,
 	aip0154.AddRules,
 	aip0155.AddRules,
 	aip0156.AddRules,
 	aip0157.AddRules,
 	aip0158.AddRules,
 	aip0159.AddRules,
+	aip0160.AddRules,
+	aip0161.AddRules,
 	aip0162.AddRules,
 	aip0163.AddRules,
 	aip0164.AddRules,
 	aip0165.AddRules,
 	aip0191.AddRules,
 	aip0192.AddRules,
+	aip0193.AddRules,
 	aip0202.AddRules,
 	aip0203.AddRules,
+	aip0213.AddRules,
 	aip0214.AddRules,
 	aip0215.AddRules,
 	aip0216.AddRules,
@@ -145,7 +165,11 @@ var aipAddRulesFuncs = []addRulesFuncType{
 	aip0233.AddRules,
 	aip0234.AddRules,
 	aip0235.AddRules,
+	aip2500.AddRules,
+	aip2510.AddRules,
+	aip4222.AddRules,
 	aip4232.AddRules,
+	aip4235.AddRules,
 }
 
 // Add all rules to the given registry.